@@ -66,10 +66,167 @@ func (x *_GenesisState_2_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_3_list)(nil)
+
+type _GenesisState_3_list struct {
+	list *[]*UnorderedNonces
+}
+
+func (x *_GenesisState_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnorderedNonces)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*UnorderedNonces)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_3_list) AppendMutable() protoreflect.Value {
+	v := new(UnorderedNonces)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_3_list) NewElement() protoreflect.Value {
+	v := new(UnorderedNonces)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*ExecutionReceipt
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExecutionReceipt)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ExecutionReceipt)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(ExecutionReceipt)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(ExecutionReceipt)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*QueuedOnionTx
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueuedOnionTx)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*QueuedOnionTx)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(QueuedOnionTx)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(QueuedOnionTx)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState           protoreflect.MessageDescriptor
-	fd_GenesisState_params    protoreflect.FieldDescriptor
-	fd_GenesisState_sequences protoreflect.FieldDescriptor
+	md_GenesisState                    protoreflect.MessageDescriptor
+	fd_GenesisState_params             protoreflect.FieldDescriptor
+	fd_GenesisState_sequences          protoreflect.FieldDescriptor
+	fd_GenesisState_unordered_nonces   protoreflect.FieldDescriptor
+	fd_GenesisState_execution_receipts protoreflect.FieldDescriptor
+	fd_GenesisState_queued_txs         protoreflect.FieldDescriptor
+	fd_GenesisState_next_queued_tx_id  protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -77,6 +234,10 @@ func init() {
 	md_GenesisState = File_onion_onion_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_sequences = md_GenesisState.Fields().ByName("sequences")
+	fd_GenesisState_unordered_nonces = md_GenesisState.Fields().ByName("unordered_nonces")
+	fd_GenesisState_execution_receipts = md_GenesisState.Fields().ByName("execution_receipts")
+	fd_GenesisState_queued_txs = md_GenesisState.Fields().ByName("queued_txs")
+	fd_GenesisState_next_queued_tx_id = md_GenesisState.Fields().ByName("next_queued_tx_id")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -156,6 +317,30 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.UnorderedNonces) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.UnorderedNonces})
+		if !f(fd_GenesisState_unordered_nonces, value) {
+			return
+		}
+	}
+	if len(x.ExecutionReceipts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.ExecutionReceipts})
+		if !f(fd_GenesisState_execution_receipts, value) {
+			return
+		}
+	}
+	if len(x.QueuedTxs) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.QueuedTxs})
+		if !f(fd_GenesisState_queued_txs, value) {
+			return
+		}
+	}
+	if x.NextQueuedTxId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NextQueuedTxId)
+		if !f(fd_GenesisState_next_queued_tx_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -175,6 +360,14 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.Params != nil
 	case "onion.onion.GenesisState.sequences":
 		return len(x.Sequences) != 0
+	case "onion.onion.GenesisState.unordered_nonces":
+		return len(x.UnorderedNonces) != 0
+	case "onion.onion.GenesisState.execution_receipts":
+		return len(x.ExecutionReceipts) != 0
+	case "onion.onion.GenesisState.queued_txs":
+		return len(x.QueuedTxs) != 0
+	case "onion.onion.GenesisState.next_queued_tx_id":
+		return x.NextQueuedTxId != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.GenesisState"))
@@ -195,6 +388,14 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Params = nil
 	case "onion.onion.GenesisState.sequences":
 		x.Sequences = nil
+	case "onion.onion.GenesisState.unordered_nonces":
+		x.UnorderedNonces = nil
+	case "onion.onion.GenesisState.execution_receipts":
+		x.ExecutionReceipts = nil
+	case "onion.onion.GenesisState.queued_txs":
+		x.QueuedTxs = nil
+	case "onion.onion.GenesisState.next_queued_tx_id":
+		x.NextQueuedTxId = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.GenesisState"))
@@ -220,6 +421,27 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_2_list{list: &x.Sequences}
 		return protoreflect.ValueOfList(listValue)
+	case "onion.onion.GenesisState.unordered_nonces":
+		if len(x.UnorderedNonces) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.UnorderedNonces}
+		return protoreflect.ValueOfList(listValue)
+	case "onion.onion.GenesisState.execution_receipts":
+		if len(x.ExecutionReceipts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.ExecutionReceipts}
+		return protoreflect.ValueOfList(listValue)
+	case "onion.onion.GenesisState.queued_txs":
+		if len(x.QueuedTxs) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.QueuedTxs}
+		return protoreflect.ValueOfList(listValue)
+	case "onion.onion.GenesisState.next_queued_tx_id":
+		value := x.NextQueuedTxId
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.GenesisState"))
@@ -246,6 +468,20 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_2_list)
 		x.Sequences = *clv.list
+	case "onion.onion.GenesisState.unordered_nonces":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.UnorderedNonces = *clv.list
+	case "onion.onion.GenesisState.execution_receipts":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.ExecutionReceipts = *clv.list
+	case "onion.onion.GenesisState.queued_txs":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.QueuedTxs = *clv.list
+	case "onion.onion.GenesisState.next_queued_tx_id":
+		x.NextQueuedTxId = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.GenesisState"))
@@ -277,6 +513,26 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_2_list{list: &x.Sequences}
 		return protoreflect.ValueOfList(value)
+	case "onion.onion.GenesisState.unordered_nonces":
+		if x.UnorderedNonces == nil {
+			x.UnorderedNonces = []*UnorderedNonces{}
+		}
+		value := &_GenesisState_3_list{list: &x.UnorderedNonces}
+		return protoreflect.ValueOfList(value)
+	case "onion.onion.GenesisState.execution_receipts":
+		if x.ExecutionReceipts == nil {
+			x.ExecutionReceipts = []*ExecutionReceipt{}
+		}
+		value := &_GenesisState_4_list{list: &x.ExecutionReceipts}
+		return protoreflect.ValueOfList(value)
+	case "onion.onion.GenesisState.queued_txs":
+		if x.QueuedTxs == nil {
+			x.QueuedTxs = []*QueuedOnionTx{}
+		}
+		value := &_GenesisState_5_list{list: &x.QueuedTxs}
+		return protoreflect.ValueOfList(value)
+	case "onion.onion.GenesisState.next_queued_tx_id":
+		panic(fmt.Errorf("field next_queued_tx_id of message onion.onion.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.GenesisState"))
@@ -296,6 +552,17 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "onion.onion.GenesisState.sequences":
 		list := []*OnionSequence{}
 		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "onion.onion.GenesisState.unordered_nonces":
+		list := []*UnorderedNonces{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "onion.onion.GenesisState.execution_receipts":
+		list := []*ExecutionReceipt{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "onion.onion.GenesisState.queued_txs":
+		list := []*QueuedOnionTx{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "onion.onion.GenesisState.next_queued_tx_id":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.GenesisState"))
@@ -375,6 +642,27 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.UnorderedNonces) > 0 {
+			for _, e := range x.UnorderedNonces {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ExecutionReceipts) > 0 {
+			for _, e := range x.ExecutionReceipts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.QueuedTxs) > 0 {
+			for _, e := range x.QueuedTxs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextQueuedTxId != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextQueuedTxId))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -404,6 +692,59 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.NextQueuedTxId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextQueuedTxId))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.QueuedTxs) > 0 {
+			for iNdEx := len(x.QueuedTxs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.QueuedTxs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.ExecutionReceipts) > 0 {
+			for iNdEx := len(x.ExecutionReceipts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ExecutionReceipts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.UnorderedNonces) > 0 {
+			for iNdEx := len(x.UnorderedNonces) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.UnorderedNonces[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
 		if len(x.Sequences) > 0 {
 			for iNdEx := len(x.Sequences) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Sequences[iNdEx])
@@ -445,7 +786,644 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequences", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sequences = append(x.Sequences, &OnionSequence{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Sequences[len(x.Sequences)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnorderedNonces", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UnorderedNonces = append(x.UnorderedNonces, &UnorderedNonces{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.UnorderedNonces[len(x.UnorderedNonces)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionReceipts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ExecutionReceipts = append(x.ExecutionReceipts, &ExecutionReceipt{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ExecutionReceipts[len(x.ExecutionReceipts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QueuedTxs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.QueuedTxs = append(x.QueuedTxs, &QueuedOnionTx{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.QueuedTxs[len(x.QueuedTxs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextQueuedTxId", wireType)
+				}
+				x.NextQueuedTxId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextQueuedTxId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_OnionSequence                  protoreflect.MessageDescriptor
+	fd_OnionSequence_address          protoreflect.FieldDescriptor
+	fd_OnionSequence_sequence         protoreflect.FieldDescriptor
+	fd_OnionSequence_last_used_height protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_onion_onion_genesis_proto_init()
+	md_OnionSequence = File_onion_onion_genesis_proto.Messages().ByName("OnionSequence")
+	fd_OnionSequence_address = md_OnionSequence.Fields().ByName("address")
+	fd_OnionSequence_sequence = md_OnionSequence.Fields().ByName("sequence")
+	fd_OnionSequence_last_used_height = md_OnionSequence.Fields().ByName("last_used_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_OnionSequence)(nil)
+
+type fastReflection_OnionSequence OnionSequence
+
+func (x *OnionSequence) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_OnionSequence)(x)
+}
+
+func (x *OnionSequence) slowProtoReflect() protoreflect.Message {
+	mi := &file_onion_onion_genesis_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_OnionSequence_messageType fastReflection_OnionSequence_messageType
+var _ protoreflect.MessageType = fastReflection_OnionSequence_messageType{}
+
+type fastReflection_OnionSequence_messageType struct{}
+
+func (x fastReflection_OnionSequence_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_OnionSequence)(nil)
+}
+func (x fastReflection_OnionSequence_messageType) New() protoreflect.Message {
+	return new(fastReflection_OnionSequence)
+}
+func (x fastReflection_OnionSequence_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_OnionSequence
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_OnionSequence) Descriptor() protoreflect.MessageDescriptor {
+	return md_OnionSequence
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_OnionSequence) Type() protoreflect.MessageType {
+	return _fastReflection_OnionSequence_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_OnionSequence) New() protoreflect.Message {
+	return new(fastReflection_OnionSequence)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_OnionSequence) Interface() protoreflect.ProtoMessage {
+	return (*OnionSequence)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_OnionSequence) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_OnionSequence_address, value) {
+			return
+		}
+	}
+	if x.Sequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Sequence)
+		if !f(fd_OnionSequence_sequence, value) {
+			return
+		}
+	}
+	if x.LastUsedHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastUsedHeight)
+		if !f(fd_OnionSequence_last_used_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_OnionSequence) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "onion.onion.OnionSequence.address":
+		return x.Address != ""
+	case "onion.onion.OnionSequence.sequence":
+		return x.Sequence != uint64(0)
+	case "onion.onion.OnionSequence.last_used_height":
+		return x.LastUsedHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.OnionSequence"))
+		}
+		panic(fmt.Errorf("message onion.onion.OnionSequence does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OnionSequence) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "onion.onion.OnionSequence.address":
+		x.Address = ""
+	case "onion.onion.OnionSequence.sequence":
+		x.Sequence = uint64(0)
+	case "onion.onion.OnionSequence.last_used_height":
+		x.LastUsedHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.OnionSequence"))
+		}
+		panic(fmt.Errorf("message onion.onion.OnionSequence does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_OnionSequence) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "onion.onion.OnionSequence.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "onion.onion.OnionSequence.sequence":
+		value := x.Sequence
+		return protoreflect.ValueOfUint64(value)
+	case "onion.onion.OnionSequence.last_used_height":
+		value := x.LastUsedHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.OnionSequence"))
+		}
+		panic(fmt.Errorf("message onion.onion.OnionSequence does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OnionSequence) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "onion.onion.OnionSequence.address":
+		x.Address = value.Interface().(string)
+	case "onion.onion.OnionSequence.sequence":
+		x.Sequence = value.Uint()
+	case "onion.onion.OnionSequence.last_used_height":
+		x.LastUsedHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.OnionSequence"))
+		}
+		panic(fmt.Errorf("message onion.onion.OnionSequence does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OnionSequence) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "onion.onion.OnionSequence.address":
+		panic(fmt.Errorf("field address of message onion.onion.OnionSequence is not mutable"))
+	case "onion.onion.OnionSequence.sequence":
+		panic(fmt.Errorf("field sequence of message onion.onion.OnionSequence is not mutable"))
+	case "onion.onion.OnionSequence.last_used_height":
+		panic(fmt.Errorf("field last_used_height of message onion.onion.OnionSequence is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.OnionSequence"))
+		}
+		panic(fmt.Errorf("message onion.onion.OnionSequence does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_OnionSequence) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "onion.onion.OnionSequence.address":
+		return protoreflect.ValueOfString("")
+	case "onion.onion.OnionSequence.sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "onion.onion.OnionSequence.last_used_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.OnionSequence"))
+		}
+		panic(fmt.Errorf("message onion.onion.OnionSequence does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_OnionSequence) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in onion.onion.OnionSequence", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_OnionSequence) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_OnionSequence) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_OnionSequence) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_OnionSequence) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*OnionSequence)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Sequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.Sequence))
+		}
+		if x.LastUsedHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastUsedHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*OnionSequence)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastUsedHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastUsedHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Sequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*OnionSequence)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -477,17 +1455,17 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OnionSequence: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OnionSequence: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -497,33 +1475,29 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequences", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 				}
-				var msglen int
+				x.Sequence = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -533,26 +1507,30 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.Sequence |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastUsedHeight", wireType)
 				}
-				x.Sequences = append(x.Sequences, &OnionSequence{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Sequences[len(x.Sequences)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.LastUsedHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastUsedHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -589,28 +1567,30 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_OnionSequence          protoreflect.MessageDescriptor
-	fd_OnionSequence_address  protoreflect.FieldDescriptor
-	fd_OnionSequence_sequence protoreflect.FieldDescriptor
+	md_UnorderedNonces         protoreflect.MessageDescriptor
+	fd_UnorderedNonces_address protoreflect.FieldDescriptor
+	fd_UnorderedNonces_highest protoreflect.FieldDescriptor
+	fd_UnorderedNonces_bitmap  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_onion_onion_genesis_proto_init()
-	md_OnionSequence = File_onion_onion_genesis_proto.Messages().ByName("OnionSequence")
-	fd_OnionSequence_address = md_OnionSequence.Fields().ByName("address")
-	fd_OnionSequence_sequence = md_OnionSequence.Fields().ByName("sequence")
+	md_UnorderedNonces = File_onion_onion_genesis_proto.Messages().ByName("UnorderedNonces")
+	fd_UnorderedNonces_address = md_UnorderedNonces.Fields().ByName("address")
+	fd_UnorderedNonces_highest = md_UnorderedNonces.Fields().ByName("highest")
+	fd_UnorderedNonces_bitmap = md_UnorderedNonces.Fields().ByName("bitmap")
 }
 
-var _ protoreflect.Message = (*fastReflection_OnionSequence)(nil)
+var _ protoreflect.Message = (*fastReflection_UnorderedNonces)(nil)
 
-type fastReflection_OnionSequence OnionSequence
+type fastReflection_UnorderedNonces UnorderedNonces
 
-func (x *OnionSequence) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_OnionSequence)(x)
+func (x *UnorderedNonces) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_UnorderedNonces)(x)
 }
 
-func (x *OnionSequence) slowProtoReflect() protoreflect.Message {
-	mi := &file_onion_onion_genesis_proto_msgTypes[1]
+func (x *UnorderedNonces) slowProtoReflect() protoreflect.Message {
+	mi := &file_onion_onion_genesis_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -621,43 +1601,43 @@ func (x *OnionSequence) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_OnionSequence_messageType fastReflection_OnionSequence_messageType
-var _ protoreflect.MessageType = fastReflection_OnionSequence_messageType{}
+var _fastReflection_UnorderedNonces_messageType fastReflection_UnorderedNonces_messageType
+var _ protoreflect.MessageType = fastReflection_UnorderedNonces_messageType{}
 
-type fastReflection_OnionSequence_messageType struct{}
+type fastReflection_UnorderedNonces_messageType struct{}
 
-func (x fastReflection_OnionSequence_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_OnionSequence)(nil)
+func (x fastReflection_UnorderedNonces_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_UnorderedNonces)(nil)
 }
-func (x fastReflection_OnionSequence_messageType) New() protoreflect.Message {
-	return new(fastReflection_OnionSequence)
+func (x fastReflection_UnorderedNonces_messageType) New() protoreflect.Message {
+	return new(fastReflection_UnorderedNonces)
 }
-func (x fastReflection_OnionSequence_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_OnionSequence
+func (x fastReflection_UnorderedNonces_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnorderedNonces
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_OnionSequence) Descriptor() protoreflect.MessageDescriptor {
-	return md_OnionSequence
+func (x *fastReflection_UnorderedNonces) Descriptor() protoreflect.MessageDescriptor {
+	return md_UnorderedNonces
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_OnionSequence) Type() protoreflect.MessageType {
-	return _fastReflection_OnionSequence_messageType
+func (x *fastReflection_UnorderedNonces) Type() protoreflect.MessageType {
+	return _fastReflection_UnorderedNonces_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_OnionSequence) New() protoreflect.Message {
-	return new(fastReflection_OnionSequence)
+func (x *fastReflection_UnorderedNonces) New() protoreflect.Message {
+	return new(fastReflection_UnorderedNonces)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_OnionSequence) Interface() protoreflect.ProtoMessage {
-	return (*OnionSequence)(x)
+func (x *fastReflection_UnorderedNonces) Interface() protoreflect.ProtoMessage {
+	return (*UnorderedNonces)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -665,16 +1645,22 @@ func (x *fastReflection_OnionSequence) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_OnionSequence) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_UnorderedNonces) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Address != "" {
 		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_OnionSequence_address, value) {
+		if !f(fd_UnorderedNonces_address, value) {
 			return
 		}
 	}
-	if x.Sequence != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Sequence)
-		if !f(fd_OnionSequence_sequence, value) {
+	if x.Highest != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Highest)
+		if !f(fd_UnorderedNonces_highest, value) {
+			return
+		}
+	}
+	if len(x.Bitmap) != 0 {
+		value := protoreflect.ValueOfBytes(x.Bitmap)
+		if !f(fd_UnorderedNonces_bitmap, value) {
 			return
 		}
 	}
@@ -691,17 +1677,19 @@ func (x *fastReflection_OnionSequence) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_OnionSequence) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_UnorderedNonces) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "onion.onion.OnionSequence.address":
+	case "onion.onion.UnorderedNonces.address":
 		return x.Address != ""
-	case "onion.onion.OnionSequence.sequence":
-		return x.Sequence != uint64(0)
+	case "onion.onion.UnorderedNonces.highest":
+		return x.Highest != uint64(0)
+	case "onion.onion.UnorderedNonces.bitmap":
+		return len(x.Bitmap) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.OnionSequence"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.UnorderedNonces"))
 		}
-		panic(fmt.Errorf("message onion.onion.OnionSequence does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message onion.onion.UnorderedNonces does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -711,17 +1699,19 @@ func (x *fastReflection_OnionSequence) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OnionSequence) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_UnorderedNonces) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "onion.onion.OnionSequence.address":
+	case "onion.onion.UnorderedNonces.address":
 		x.Address = ""
-	case "onion.onion.OnionSequence.sequence":
-		x.Sequence = uint64(0)
+	case "onion.onion.UnorderedNonces.highest":
+		x.Highest = uint64(0)
+	case "onion.onion.UnorderedNonces.bitmap":
+		x.Bitmap = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.OnionSequence"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.UnorderedNonces"))
 		}
-		panic(fmt.Errorf("message onion.onion.OnionSequence does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message onion.onion.UnorderedNonces does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -731,19 +1721,22 @@ func (x *fastReflection_OnionSequence) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_OnionSequence) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_UnorderedNonces) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "onion.onion.OnionSequence.address":
+	case "onion.onion.UnorderedNonces.address":
 		value := x.Address
 		return protoreflect.ValueOfString(value)
-	case "onion.onion.OnionSequence.sequence":
-		value := x.Sequence
+	case "onion.onion.UnorderedNonces.highest":
+		value := x.Highest
 		return protoreflect.ValueOfUint64(value)
+	case "onion.onion.UnorderedNonces.bitmap":
+		value := x.Bitmap
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.OnionSequence"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.UnorderedNonces"))
 		}
-		panic(fmt.Errorf("message onion.onion.OnionSequence does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message onion.onion.UnorderedNonces does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -757,17 +1750,19 @@ func (x *fastReflection_OnionSequence) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OnionSequence) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_UnorderedNonces) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "onion.onion.OnionSequence.address":
+	case "onion.onion.UnorderedNonces.address":
 		x.Address = value.Interface().(string)
-	case "onion.onion.OnionSequence.sequence":
-		x.Sequence = value.Uint()
+	case "onion.onion.UnorderedNonces.highest":
+		x.Highest = value.Uint()
+	case "onion.onion.UnorderedNonces.bitmap":
+		x.Bitmap = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.OnionSequence"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.UnorderedNonces"))
 		}
-		panic(fmt.Errorf("message onion.onion.OnionSequence does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message onion.onion.UnorderedNonces does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -781,44 +1776,48 @@ func (x *fastReflection_OnionSequence) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OnionSequence) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_UnorderedNonces) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "onion.onion.OnionSequence.address":
-		panic(fmt.Errorf("field address of message onion.onion.OnionSequence is not mutable"))
-	case "onion.onion.OnionSequence.sequence":
-		panic(fmt.Errorf("field sequence of message onion.onion.OnionSequence is not mutable"))
+	case "onion.onion.UnorderedNonces.address":
+		panic(fmt.Errorf("field address of message onion.onion.UnorderedNonces is not mutable"))
+	case "onion.onion.UnorderedNonces.highest":
+		panic(fmt.Errorf("field highest of message onion.onion.UnorderedNonces is not mutable"))
+	case "onion.onion.UnorderedNonces.bitmap":
+		panic(fmt.Errorf("field bitmap of message onion.onion.UnorderedNonces is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.OnionSequence"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.UnorderedNonces"))
 		}
-		panic(fmt.Errorf("message onion.onion.OnionSequence does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message onion.onion.UnorderedNonces does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_OnionSequence) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_UnorderedNonces) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "onion.onion.OnionSequence.address":
+	case "onion.onion.UnorderedNonces.address":
 		return protoreflect.ValueOfString("")
-	case "onion.onion.OnionSequence.sequence":
+	case "onion.onion.UnorderedNonces.highest":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "onion.onion.UnorderedNonces.bitmap":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.OnionSequence"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.UnorderedNonces"))
 		}
-		panic(fmt.Errorf("message onion.onion.OnionSequence does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message onion.onion.UnorderedNonces does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_OnionSequence) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_UnorderedNonces) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in onion.onion.OnionSequence", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in onion.onion.UnorderedNonces", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -826,7 +1825,7 @@ func (x *fastReflection_OnionSequence) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_OnionSequence) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_UnorderedNonces) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -837,7 +1836,7 @@ func (x *fastReflection_OnionSequence) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_OnionSequence) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_UnorderedNonces) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -849,7 +1848,7 @@ func (x *fastReflection_OnionSequence) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_OnionSequence) IsValid() bool {
+func (x *fastReflection_UnorderedNonces) IsValid() bool {
 	return x != nil
 }
 
@@ -859,9 +1858,9 @@ func (x *fastReflection_OnionSequence) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_OnionSequence) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_UnorderedNonces) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*OnionSequence)
+		x := input.Message.Interface().(*UnorderedNonces)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -877,8 +1876,12 @@ func (x *fastReflection_OnionSequence) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Sequence != 0 {
-			n += 1 + runtime.Sov(uint64(x.Sequence))
+		if x.Highest != 0 {
+			n += 1 + runtime.Sov(uint64(x.Highest))
+		}
+		l = len(x.Bitmap)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -890,7 +1893,7 @@ func (x *fastReflection_OnionSequence) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*OnionSequence)
+		x := input.Message.Interface().(*UnorderedNonces)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -909,8 +1912,15 @@ func (x *fastReflection_OnionSequence) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Sequence != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Sequence))
+		if len(x.Bitmap) > 0 {
+			i -= len(x.Bitmap)
+			copy(dAtA[i:], x.Bitmap)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bitmap)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Highest != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Highest))
 			i--
 			dAtA[i] = 0x10
 		}
@@ -932,7 +1942,7 @@ func (x *fastReflection_OnionSequence) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*OnionSequence)
+		x := input.Message.Interface().(*UnorderedNonces)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -964,10 +1974,10 @@ func (x *fastReflection_OnionSequence) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OnionSequence: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnorderedNonces: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: OnionSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: UnorderedNonces: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -1004,9 +2014,9 @@ func (x *fastReflection_OnionSequence) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Highest", wireType)
 				}
-				x.Sequence = 0
+				x.Highest = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1016,11 +2026,45 @@ func (x *fastReflection_OnionSequence) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Sequence |= uint64(b&0x7F) << shift
+					x.Highest |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bitmap", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bitmap = append(x.Bitmap[:0], dAtA[iNdEx:postIndex]...)
+				if x.Bitmap == nil {
+					x.Bitmap = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1074,8 +2118,17 @@ type GenesisState struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Params    *Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	Sequences []*OnionSequence `protobuf:"bytes,2,rep,name=sequences,proto3" json:"sequences,omitempty"`
+	Params          *Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Sequences       []*OnionSequence   `protobuf:"bytes,2,rep,name=sequences,proto3" json:"sequences,omitempty"`
+	UnorderedNonces []*UnorderedNonces `protobuf:"bytes,3,rep,name=unordered_nonces,json=unorderedNonces,proto3" json:"unordered_nonces,omitempty"`
+	// execution_receipts are the stored receipts of received packets, pruned
+	// by height as usual after the import.
+	ExecutionReceipts []*ExecutionReceipt `protobuf:"bytes,4,rep,name=execution_receipts,json=executionReceipts,proto3" json:"execution_receipts,omitempty"`
+	// queued_txs are the onion txs kept in the retry queue.
+	QueuedTxs []*QueuedOnionTx `protobuf:"bytes,5,rep,name=queued_txs,json=queuedTxs,proto3" json:"queued_txs,omitempty"`
+	// next_queued_tx_id is the id the next queued onion tx gets, it is above
+	// the ids of queued_txs. Zero starts the ids at 1.
+	NextQueuedTxId uint64 `protobuf:"varint,6,opt,name=next_queued_tx_id,json=nextQueuedTxId,proto3" json:"next_queued_tx_id,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1112,6 +2165,34 @@ func (x *GenesisState) GetSequences() []*OnionSequence {
 	return nil
 }
 
+func (x *GenesisState) GetUnorderedNonces() []*UnorderedNonces {
+	if x != nil {
+		return x.UnorderedNonces
+	}
+	return nil
+}
+
+func (x *GenesisState) GetExecutionReceipts() []*ExecutionReceipt {
+	if x != nil {
+		return x.ExecutionReceipts
+	}
+	return nil
+}
+
+func (x *GenesisState) GetQueuedTxs() []*QueuedOnionTx {
+	if x != nil {
+		return x.QueuedTxs
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextQueuedTxId() uint64 {
+	if x != nil {
+		return x.NextQueuedTxId
+	}
+	return 0
+}
+
 type OnionSequence struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1119,6 +2200,9 @@ type OnionSequence struct {
 
 	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// last_used_height is the height of the last onion tx signed by address,
+	// in strict or unordered mode.
+	LastUsedHeight int64 `protobuf:"varint,3,opt,name=last_used_height,json=lastUsedHeight,proto3" json:"last_used_height,omitempty"`
 }
 
 func (x *OnionSequence) Reset() {
@@ -1155,6 +2239,67 @@ func (x *OnionSequence) GetSequence() uint64 {
 	return 0
 }
 
+func (x *OnionSequence) GetLastUsedHeight() int64 {
+	if x != nil {
+		return x.LastUsedHeight
+	}
+	return 0
+}
+
+// UnorderedNonces tracks the nonces an address has used for onion txs in
+// unordered mode. bitmap covers the window of nonces ending at highest, bit i
+// is set when nonce highest - i has been used.
+type UnorderedNonces struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Highest uint64 `protobuf:"varint,2,opt,name=highest,proto3" json:"highest,omitempty"`
+	Bitmap  []byte `protobuf:"bytes,3,opt,name=bitmap,proto3" json:"bitmap,omitempty"`
+}
+
+func (x *UnorderedNonces) Reset() {
+	*x = UnorderedNonces{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_onion_onion_genesis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnorderedNonces) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnorderedNonces) ProtoMessage() {}
+
+// Deprecated: Use UnorderedNonces.ProtoReflect.Descriptor instead.
+func (*UnorderedNonces) Descriptor() ([]byte, []int) {
+	return file_onion_onion_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UnorderedNonces) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UnorderedNonces) GetHighest() uint64 {
+	if x != nil {
+		return x.Highest
+	}
+	return 0
+}
+
+func (x *UnorderedNonces) GetBitmap() []byte {
+	if x != nil {
+		return x.Bitmap
+	}
+	return nil
+}
+
 var File_onion_onion_genesis_proto protoreflect.FileDescriptor
 
 var file_onion_onion_genesis_proto_rawDesc = []byte{
@@ -1166,29 +2311,58 @@ var file_onion_onion_genesis_proto_rawDesc = []byte{
 	0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6f, 0x6e,
 	0x69, 0x6f, 0x6e, 0x2f, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x81, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73,
-	0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2e,
-	0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3e, 0x0a, 0x09, 0x73, 0x65,
-	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x6e, 0x69, 0x6f,
-	0x6e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x09, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x45, 0x0a, 0x0d, 0x4f, 0x6e,
-	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
-	0x65, 0x42, 0x8a, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2e,
-	0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2f, 0x6f, 0x6e,
-	0x69, 0x6f, 0x6e, 0xa2, 0x02, 0x03, 0x4f, 0x4f, 0x58, 0xaa, 0x02, 0x0b, 0x4f, 0x6e, 0x69, 0x6f,
-	0x6e, 0x2e, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0xca, 0x02, 0x0b, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x5c,
-	0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0xe2, 0x02, 0x17, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x5c, 0x4f, 0x6e,
-	0x69, 0x6f, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x0c, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2f, 0x6f, 0x6e,
+	0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x17, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2f, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2f, 0x72,
+	0x65, 0x74, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x90, 0x03, 0x0a, 0x0c, 0x47,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6f, 0x6e,
+	0x69, 0x6f, 0x6e, 0x2e, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3e,
+	0x0a, 0x09, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2e,
+	0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x09, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x4d,
+	0x0a, 0x10, 0x75, 0x6e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6f, 0x6e, 0x69, 0x6f, 0x6e,
+	0x2e, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x55, 0x6e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64,
+	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x75, 0x6e,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x52, 0x0a,
+	0x12, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6f, 0x6e, 0x69, 0x6f,
+	0x6e, 0x2e, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x74, 0x78, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x6f, 0x6e,
+	0x69, 0x6f, 0x6e, 0x2e, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x54,
+	0x78, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x54,
+	0x78, 0x73, 0x12, 0x29, 0x0a, 0x11, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65,
+	0x64, 0x5f, 0x74, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x6e,
+	0x65, 0x78, 0x74, 0x51, 0x75, 0x65, 0x75, 0x65, 0x64, 0x54, 0x78, 0x49, 0x64, 0x22, 0x6f, 0x0a,
+	0x0d, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65,
+	0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x6c, 0x61, 0x73, 0x74, 0x55, 0x73, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x5d,
+	0x0a, 0x0f, 0x55, 0x6e, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x68,
+	0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x68, 0x69,
+	0x67, 0x68, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x69, 0x74, 0x6d, 0x61, 0x70, 0x42, 0x8a, 0x01,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x6f, 0x6e, 0x69, 0x6f,
+	0x6e, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2f, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0xa2,
+	0x02, 0x03, 0x4f, 0x4f, 0x58, 0xaa, 0x02, 0x0b, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x6e,
+	0x69, 0x6f, 0x6e, 0xca, 0x02, 0x0b, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x5c, 0x4f, 0x6e, 0x69, 0x6f,
+	0x6e, 0xe2, 0x02, 0x17, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x5c, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x4f, 0x6e,
+	0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -1203,20 +2377,26 @@ func file_onion_onion_genesis_proto_rawDescGZIP() []byte {
 	return file_onion_onion_genesis_proto_rawDescData
 }
 
-var file_onion_onion_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_onion_onion_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_onion_onion_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),  // 0: onion.onion.GenesisState
-	(*OnionSequence)(nil), // 1: onion.onion.OnionSequence
-	(*Params)(nil),        // 2: onion.onion.Params
+	(*GenesisState)(nil),     // 0: onion.onion.GenesisState
+	(*OnionSequence)(nil),    // 1: onion.onion.OnionSequence
+	(*UnorderedNonces)(nil),  // 2: onion.onion.UnorderedNonces
+	(*Params)(nil),           // 3: onion.onion.Params
+	(*ExecutionReceipt)(nil), // 4: onion.onion.ExecutionReceipt
+	(*QueuedOnionTx)(nil),    // 5: onion.onion.QueuedOnionTx
 }
 var file_onion_onion_genesis_proto_depIdxs = []int32{
-	2, // 0: onion.onion.GenesisState.params:type_name -> onion.onion.Params
+	3, // 0: onion.onion.GenesisState.params:type_name -> onion.onion.Params
 	1, // 1: onion.onion.GenesisState.sequences:type_name -> onion.onion.OnionSequence
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2, // 2: onion.onion.GenesisState.unordered_nonces:type_name -> onion.onion.UnorderedNonces
+	4, // 3: onion.onion.GenesisState.execution_receipts:type_name -> onion.onion.ExecutionReceipt
+	5, // 4: onion.onion.GenesisState.queued_txs:type_name -> onion.onion.QueuedOnionTx
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_onion_onion_genesis_proto_init() }
@@ -1225,6 +2405,8 @@ func file_onion_onion_genesis_proto_init() {
 		return
 	}
 	file_onion_onion_params_proto_init()
+	file_onion_onion_receipt_proto_init()
+	file_onion_onion_retry_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_onion_onion_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -1250,6 +2432,18 @@ func file_onion_onion_genesis_proto_init() {
 				return nil
 			}
 		}
+		file_onion_onion_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnorderedNonces); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1257,7 +2451,7 @@ func file_onion_onion_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_onion_onion_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -3,6 +3,7 @@ package onion
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -14,25 +15,1155 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_3_list)(nil)
+
+type _Params_3_list struct {
+	list *[]*v1beta1.DecCoin
+}
+
+func (x *_Params_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_4_list)(nil)
+
+type _Params_4_list struct {
+	list *[]string
+}
+
+func (x *_Params_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AllowedMsgTypes as it is not of Message kind"))
+}
+
+func (x *_Params_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_5_list)(nil)
+
+type _Params_5_list struct {
+	list *[]string
+}
+
+func (x *_Params_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field DeniedMsgTypes as it is not of Message kind"))
+}
+
+func (x *_Params_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_6_list)(nil)
+
+type _Params_6_list struct {
+	list *[]*EnabledChannel
+}
+
+func (x *_Params_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EnabledChannel)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EnabledChannel)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_6_list) AppendMutable() protoreflect.Value {
+	v := new(EnabledChannel)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_6_list) NewElement() protoreflect.Value {
+	v := new(EnabledChannel)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Params                          protoreflect.MessageDescriptor
+	fd_Params_execution_mode           protoreflect.FieldDescriptor
+	fd_Params_max_gas                  protoreflect.FieldDescriptor
+	fd_Params_min_gas_prices           protoreflect.FieldDescriptor
+	fd_Params_allowed_msg_types        protoreflect.FieldDescriptor
+	fd_Params_denied_msg_types         protoreflect.FieldDescriptor
+	fd_Params_enabled_channels         protoreflect.FieldDescriptor
+	fd_Params_legacy_memo              protoreflect.FieldDescriptor
+	fd_Params_receipt_retention_blocks protoreflect.FieldDescriptor
+	fd_Params_retry_queue_blocks       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_onion_onion_params_proto_init()
+	md_Params = File_onion_onion_params_proto.Messages().ByName("Params")
+	fd_Params_execution_mode = md_Params.Fields().ByName("execution_mode")
+	fd_Params_max_gas = md_Params.Fields().ByName("max_gas")
+	fd_Params_min_gas_prices = md_Params.Fields().ByName("min_gas_prices")
+	fd_Params_allowed_msg_types = md_Params.Fields().ByName("allowed_msg_types")
+	fd_Params_denied_msg_types = md_Params.Fields().ByName("denied_msg_types")
+	fd_Params_enabled_channels = md_Params.Fields().ByName("enabled_channels")
+	fd_Params_legacy_memo = md_Params.Fields().ByName("legacy_memo")
+	fd_Params_receipt_retention_blocks = md_Params.Fields().ByName("receipt_retention_blocks")
+	fd_Params_retry_queue_blocks = md_Params.Fields().ByName("retry_queue_blocks")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_onion_onion_params_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ExecutionMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ExecutionMode))
+		if !f(fd_Params_execution_mode, value) {
+			return
+		}
+	}
+	if x.MaxGas != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MaxGas)
+		if !f(fd_Params_max_gas, value) {
+			return
+		}
+	}
+	if len(x.MinGasPrices) != 0 {
+		value := protoreflect.ValueOfList(&_Params_3_list{list: &x.MinGasPrices})
+		if !f(fd_Params_min_gas_prices, value) {
+			return
+		}
+	}
+	if len(x.AllowedMsgTypes) != 0 {
+		value := protoreflect.ValueOfList(&_Params_4_list{list: &x.AllowedMsgTypes})
+		if !f(fd_Params_allowed_msg_types, value) {
+			return
+		}
+	}
+	if len(x.DeniedMsgTypes) != 0 {
+		value := protoreflect.ValueOfList(&_Params_5_list{list: &x.DeniedMsgTypes})
+		if !f(fd_Params_denied_msg_types, value) {
+			return
+		}
+	}
+	if len(x.EnabledChannels) != 0 {
+		value := protoreflect.ValueOfList(&_Params_6_list{list: &x.EnabledChannels})
+		if !f(fd_Params_enabled_channels, value) {
+			return
+		}
+	}
+	if x.LegacyMemo != false {
+		value := protoreflect.ValueOfBool(x.LegacyMemo)
+		if !f(fd_Params_legacy_memo, value) {
+			return
+		}
+	}
+	if x.ReceiptRetentionBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ReceiptRetentionBlocks)
+		if !f(fd_Params_receipt_retention_blocks, value) {
+			return
+		}
+	}
+	if x.RetryQueueBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RetryQueueBlocks)
+		if !f(fd_Params_retry_queue_blocks, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "onion.onion.Params.execution_mode":
+		return x.ExecutionMode != 0
+	case "onion.onion.Params.max_gas":
+		return x.MaxGas != uint64(0)
+	case "onion.onion.Params.min_gas_prices":
+		return len(x.MinGasPrices) != 0
+	case "onion.onion.Params.allowed_msg_types":
+		return len(x.AllowedMsgTypes) != 0
+	case "onion.onion.Params.denied_msg_types":
+		return len(x.DeniedMsgTypes) != 0
+	case "onion.onion.Params.enabled_channels":
+		return len(x.EnabledChannels) != 0
+	case "onion.onion.Params.legacy_memo":
+		return x.LegacyMemo != false
+	case "onion.onion.Params.receipt_retention_blocks":
+		return x.ReceiptRetentionBlocks != uint64(0)
+	case "onion.onion.Params.retry_queue_blocks":
+		return x.RetryQueueBlocks != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.Params"))
+		}
+		panic(fmt.Errorf("message onion.onion.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "onion.onion.Params.execution_mode":
+		x.ExecutionMode = 0
+	case "onion.onion.Params.max_gas":
+		x.MaxGas = uint64(0)
+	case "onion.onion.Params.min_gas_prices":
+		x.MinGasPrices = nil
+	case "onion.onion.Params.allowed_msg_types":
+		x.AllowedMsgTypes = nil
+	case "onion.onion.Params.denied_msg_types":
+		x.DeniedMsgTypes = nil
+	case "onion.onion.Params.enabled_channels":
+		x.EnabledChannels = nil
+	case "onion.onion.Params.legacy_memo":
+		x.LegacyMemo = false
+	case "onion.onion.Params.receipt_retention_blocks":
+		x.ReceiptRetentionBlocks = uint64(0)
+	case "onion.onion.Params.retry_queue_blocks":
+		x.RetryQueueBlocks = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.Params"))
+		}
+		panic(fmt.Errorf("message onion.onion.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "onion.onion.Params.execution_mode":
+		value := x.ExecutionMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "onion.onion.Params.max_gas":
+		value := x.MaxGas
+		return protoreflect.ValueOfUint64(value)
+	case "onion.onion.Params.min_gas_prices":
+		if len(x.MinGasPrices) == 0 {
+			return protoreflect.ValueOfList(&_Params_3_list{})
+		}
+		listValue := &_Params_3_list{list: &x.MinGasPrices}
+		return protoreflect.ValueOfList(listValue)
+	case "onion.onion.Params.allowed_msg_types":
+		if len(x.AllowedMsgTypes) == 0 {
+			return protoreflect.ValueOfList(&_Params_4_list{})
+		}
+		listValue := &_Params_4_list{list: &x.AllowedMsgTypes}
+		return protoreflect.ValueOfList(listValue)
+	case "onion.onion.Params.denied_msg_types":
+		if len(x.DeniedMsgTypes) == 0 {
+			return protoreflect.ValueOfList(&_Params_5_list{})
+		}
+		listValue := &_Params_5_list{list: &x.DeniedMsgTypes}
+		return protoreflect.ValueOfList(listValue)
+	case "onion.onion.Params.enabled_channels":
+		if len(x.EnabledChannels) == 0 {
+			return protoreflect.ValueOfList(&_Params_6_list{})
+		}
+		listValue := &_Params_6_list{list: &x.EnabledChannels}
+		return protoreflect.ValueOfList(listValue)
+	case "onion.onion.Params.legacy_memo":
+		value := x.LegacyMemo
+		return protoreflect.ValueOfBool(value)
+	case "onion.onion.Params.receipt_retention_blocks":
+		value := x.ReceiptRetentionBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "onion.onion.Params.retry_queue_blocks":
+		value := x.RetryQueueBlocks
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.Params"))
+		}
+		panic(fmt.Errorf("message onion.onion.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "onion.onion.Params.execution_mode":
+		x.ExecutionMode = (ExecutionMode)(value.Enum())
+	case "onion.onion.Params.max_gas":
+		x.MaxGas = value.Uint()
+	case "onion.onion.Params.min_gas_prices":
+		lv := value.List()
+		clv := lv.(*_Params_3_list)
+		x.MinGasPrices = *clv.list
+	case "onion.onion.Params.allowed_msg_types":
+		lv := value.List()
+		clv := lv.(*_Params_4_list)
+		x.AllowedMsgTypes = *clv.list
+	case "onion.onion.Params.denied_msg_types":
+		lv := value.List()
+		clv := lv.(*_Params_5_list)
+		x.DeniedMsgTypes = *clv.list
+	case "onion.onion.Params.enabled_channels":
+		lv := value.List()
+		clv := lv.(*_Params_6_list)
+		x.EnabledChannels = *clv.list
+	case "onion.onion.Params.legacy_memo":
+		x.LegacyMemo = value.Bool()
+	case "onion.onion.Params.receipt_retention_blocks":
+		x.ReceiptRetentionBlocks = value.Uint()
+	case "onion.onion.Params.retry_queue_blocks":
+		x.RetryQueueBlocks = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.Params"))
+		}
+		panic(fmt.Errorf("message onion.onion.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "onion.onion.Params.min_gas_prices":
+		if x.MinGasPrices == nil {
+			x.MinGasPrices = []*v1beta1.DecCoin{}
+		}
+		value := &_Params_3_list{list: &x.MinGasPrices}
+		return protoreflect.ValueOfList(value)
+	case "onion.onion.Params.allowed_msg_types":
+		if x.AllowedMsgTypes == nil {
+			x.AllowedMsgTypes = []string{}
+		}
+		value := &_Params_4_list{list: &x.AllowedMsgTypes}
+		return protoreflect.ValueOfList(value)
+	case "onion.onion.Params.denied_msg_types":
+		if x.DeniedMsgTypes == nil {
+			x.DeniedMsgTypes = []string{}
+		}
+		value := &_Params_5_list{list: &x.DeniedMsgTypes}
+		return protoreflect.ValueOfList(value)
+	case "onion.onion.Params.enabled_channels":
+		if x.EnabledChannels == nil {
+			x.EnabledChannels = []*EnabledChannel{}
+		}
+		value := &_Params_6_list{list: &x.EnabledChannels}
+		return protoreflect.ValueOfList(value)
+	case "onion.onion.Params.execution_mode":
+		panic(fmt.Errorf("field execution_mode of message onion.onion.Params is not mutable"))
+	case "onion.onion.Params.max_gas":
+		panic(fmt.Errorf("field max_gas of message onion.onion.Params is not mutable"))
+	case "onion.onion.Params.legacy_memo":
+		panic(fmt.Errorf("field legacy_memo of message onion.onion.Params is not mutable"))
+	case "onion.onion.Params.receipt_retention_blocks":
+		panic(fmt.Errorf("field receipt_retention_blocks of message onion.onion.Params is not mutable"))
+	case "onion.onion.Params.retry_queue_blocks":
+		panic(fmt.Errorf("field retry_queue_blocks of message onion.onion.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.Params"))
+		}
+		panic(fmt.Errorf("message onion.onion.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "onion.onion.Params.execution_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "onion.onion.Params.max_gas":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "onion.onion.Params.min_gas_prices":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_Params_3_list{list: &list})
+	case "onion.onion.Params.allowed_msg_types":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_4_list{list: &list})
+	case "onion.onion.Params.denied_msg_types":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_5_list{list: &list})
+	case "onion.onion.Params.enabled_channels":
+		list := []*EnabledChannel{}
+		return protoreflect.ValueOfList(&_Params_6_list{list: &list})
+	case "onion.onion.Params.legacy_memo":
+		return protoreflect.ValueOfBool(false)
+	case "onion.onion.Params.receipt_retention_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "onion.onion.Params.retry_queue_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.Params"))
+		}
+		panic(fmt.Errorf("message onion.onion.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in onion.onion.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.ExecutionMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutionMode))
+		}
+		if x.MaxGas != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxGas))
+		}
+		if len(x.MinGasPrices) > 0 {
+			for _, e := range x.MinGasPrices {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AllowedMsgTypes) > 0 {
+			for _, s := range x.AllowedMsgTypes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DeniedMsgTypes) > 0 {
+			for _, s := range x.DeniedMsgTypes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.EnabledChannels) > 0 {
+			for _, e := range x.EnabledChannels {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LegacyMemo {
+			n += 2
+		}
+		if x.ReceiptRetentionBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.ReceiptRetentionBlocks))
+		}
+		if x.RetryQueueBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.RetryQueueBlocks))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RetryQueueBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RetryQueueBlocks))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.ReceiptRetentionBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ReceiptRetentionBlocks))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.LegacyMemo {
+			i--
+			if x.LegacyMemo {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.EnabledChannels) > 0 {
+			for iNdEx := len(x.EnabledChannels) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EnabledChannels[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.DeniedMsgTypes) > 0 {
+			for iNdEx := len(x.DeniedMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.DeniedMsgTypes[iNdEx])
+				copy(dAtA[i:], x.DeniedMsgTypes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DeniedMsgTypes[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.AllowedMsgTypes) > 0 {
+			for iNdEx := len(x.AllowedMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedMsgTypes[iNdEx])
+				copy(dAtA[i:], x.AllowedMsgTypes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedMsgTypes[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.MinGasPrices) > 0 {
+			for iNdEx := len(x.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinGasPrices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if x.MaxGas != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxGas))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.ExecutionMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutionMode))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionMode", wireType)
+				}
+				x.ExecutionMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutionMode |= ExecutionMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+				}
+				x.MaxGas = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxGas |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinGasPrices = append(x.MinGasPrices, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinGasPrices[len(x.MinGasPrices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgTypes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedMsgTypes = append(x.AllowedMsgTypes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DeniedMsgTypes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DeniedMsgTypes = append(x.DeniedMsgTypes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnabledChannels", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EnabledChannels = append(x.EnabledChannels, &EnabledChannel{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EnabledChannels[len(x.EnabledChannels)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LegacyMemo", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.LegacyMemo = bool(v != 0)
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReceiptRetentionBlocks", wireType)
+				}
+				x.ReceiptRetentionBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ReceiptRetentionBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetryQueueBlocks", wireType)
+				}
+				x.RetryQueueBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RetryQueueBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var (
-	md_Params protoreflect.MessageDescriptor
+	md_EnabledChannel            protoreflect.MessageDescriptor
+	fd_EnabledChannel_port_id    protoreflect.FieldDescriptor
+	fd_EnabledChannel_channel_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_onion_onion_params_proto_init()
-	md_Params = File_onion_onion_params_proto.Messages().ByName("Params")
+	md_EnabledChannel = File_onion_onion_params_proto.Messages().ByName("EnabledChannel")
+	fd_EnabledChannel_port_id = md_EnabledChannel.Fields().ByName("port_id")
+	fd_EnabledChannel_channel_id = md_EnabledChannel.Fields().ByName("channel_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_Params)(nil)
+var _ protoreflect.Message = (*fastReflection_EnabledChannel)(nil)
 
-type fastReflection_Params Params
+type fastReflection_EnabledChannel EnabledChannel
 
-func (x *Params) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Params)(x)
+func (x *EnabledChannel) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EnabledChannel)(x)
 }
 
-func (x *Params) slowProtoReflect() protoreflect.Message {
-	mi := &file_onion_onion_params_proto_msgTypes[0]
+func (x *EnabledChannel) slowProtoReflect() protoreflect.Message {
+	mi := &file_onion_onion_params_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -43,43 +1174,43 @@ func (x *Params) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Params_messageType fastReflection_Params_messageType
-var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+var _fastReflection_EnabledChannel_messageType fastReflection_EnabledChannel_messageType
+var _ protoreflect.MessageType = fastReflection_EnabledChannel_messageType{}
 
-type fastReflection_Params_messageType struct{}
+type fastReflection_EnabledChannel_messageType struct{}
 
-func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Params)(nil)
+func (x fastReflection_EnabledChannel_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EnabledChannel)(nil)
 }
-func (x fastReflection_Params_messageType) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x fastReflection_EnabledChannel_messageType) New() protoreflect.Message {
+	return new(fastReflection_EnabledChannel)
 }
-func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x fastReflection_EnabledChannel_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EnabledChannel
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x *fastReflection_EnabledChannel) Descriptor() protoreflect.MessageDescriptor {
+	return md_EnabledChannel
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Params) Type() protoreflect.MessageType {
-	return _fastReflection_Params_messageType
+func (x *fastReflection_EnabledChannel) Type() protoreflect.MessageType {
+	return _fastReflection_EnabledChannel_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Params) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x *fastReflection_EnabledChannel) New() protoreflect.Message {
+	return new(fastReflection_EnabledChannel)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
-	return (*Params)(x)
+func (x *fastReflection_EnabledChannel) Interface() protoreflect.ProtoMessage {
+	return (*EnabledChannel)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -87,7 +1218,19 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EnabledChannel) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PortId != "" {
+		value := protoreflect.ValueOfString(x.PortId)
+		if !f(fd_EnabledChannel_port_id, value) {
+			return
+		}
+	}
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_EnabledChannel_channel_id, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -101,13 +1244,17 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EnabledChannel) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "onion.onion.EnabledChannel.port_id":
+		return x.PortId != ""
+	case "onion.onion.EnabledChannel.channel_id":
+		return x.ChannelId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.EnabledChannel"))
 		}
-		panic(fmt.Errorf("message onion.onion.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message onion.onion.EnabledChannel does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -117,13 +1264,17 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EnabledChannel) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "onion.onion.EnabledChannel.port_id":
+		x.PortId = ""
+	case "onion.onion.EnabledChannel.channel_id":
+		x.ChannelId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.EnabledChannel"))
 		}
-		panic(fmt.Errorf("message onion.onion.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message onion.onion.EnabledChannel does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -133,13 +1284,19 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EnabledChannel) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "onion.onion.EnabledChannel.port_id":
+		value := x.PortId
+		return protoreflect.ValueOfString(value)
+	case "onion.onion.EnabledChannel.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.EnabledChannel"))
 		}
-		panic(fmt.Errorf("message onion.onion.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message onion.onion.EnabledChannel does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -153,13 +1310,17 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EnabledChannel) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "onion.onion.EnabledChannel.port_id":
+		x.PortId = value.Interface().(string)
+	case "onion.onion.EnabledChannel.channel_id":
+		x.ChannelId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.EnabledChannel"))
 		}
-		panic(fmt.Errorf("message onion.onion.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message onion.onion.EnabledChannel does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -173,36 +1334,44 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EnabledChannel) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "onion.onion.EnabledChannel.port_id":
+		panic(fmt.Errorf("field port_id of message onion.onion.EnabledChannel is not mutable"))
+	case "onion.onion.EnabledChannel.channel_id":
+		panic(fmt.Errorf("field channel_id of message onion.onion.EnabledChannel is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.EnabledChannel"))
 		}
-		panic(fmt.Errorf("message onion.onion.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message onion.onion.EnabledChannel does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EnabledChannel) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "onion.onion.EnabledChannel.port_id":
+		return protoreflect.ValueOfString("")
+	case "onion.onion.EnabledChannel.channel_id":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.EnabledChannel"))
 		}
-		panic(fmt.Errorf("message onion.onion.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message onion.onion.EnabledChannel does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EnabledChannel) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in onion.onion.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in onion.onion.EnabledChannel", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -210,7 +1379,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EnabledChannel) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -221,7 +1390,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EnabledChannel) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -233,7 +1402,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_EnabledChannel) IsValid() bool {
 	return x != nil
 }
 
@@ -243,9 +1412,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EnabledChannel) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*EnabledChannel)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -257,6 +1426,14 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		l = len(x.PortId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -267,7 +1444,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*EnabledChannel)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -286,6 +1463,20 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.PortId) > 0 {
+			i -= len(x.PortId)
+			copy(dAtA[i:], x.PortId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PortId)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -297,7 +1488,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*EnabledChannel)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -329,12 +1520,76 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EnabledChannel: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EnabledChannel: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PortId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -383,11 +1638,100 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ExecutionMode defines how the outcome of an onion tx is reflected in the
+// acknowledgement of the packet carrying it.
+type ExecutionMode int32
+
+const (
+	// EXECUTION_MODE_BEST_EFFORT acknowledges the transfer even when the onion
+	// tx fails, the transferred tokens stay with the receiver.
+	ExecutionMode_EXECUTION_MODE_BEST_EFFORT ExecutionMode = 0
+	// EXECUTION_MODE_ATOMIC returns an error acknowledgement when the onion tx
+	// fails, so the tokens are refunded on the source chain.
+	ExecutionMode_EXECUTION_MODE_ATOMIC ExecutionMode = 1
+)
+
+// Enum value maps for ExecutionMode.
+var (
+	ExecutionMode_name = map[int32]string{
+		0: "EXECUTION_MODE_BEST_EFFORT",
+		1: "EXECUTION_MODE_ATOMIC",
+	}
+	ExecutionMode_value = map[string]int32{
+		"EXECUTION_MODE_BEST_EFFORT": 0,
+		"EXECUTION_MODE_ATOMIC":      1,
+	}
+)
+
+func (x ExecutionMode) Enum() *ExecutionMode {
+	p := new(ExecutionMode)
+	*p = x
+	return p
+}
+
+func (x ExecutionMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExecutionMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_onion_onion_params_proto_enumTypes[0].Descriptor()
+}
+
+func (ExecutionMode) Type() protoreflect.EnumType {
+	return &file_onion_onion_params_proto_enumTypes[0]
+}
+
+func (x ExecutionMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExecutionMode.Descriptor instead.
+func (ExecutionMode) EnumDescriptor() ([]byte, []int) {
+	return file_onion_onion_params_proto_rawDescGZIP(), []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// execution_mode defines how a failed onion tx affects the ICS-20 transfer
+	// carrying it.
+	ExecutionMode ExecutionMode `protobuf:"varint,1,opt,name=execution_mode,json=executionMode,proto3,enum=onion.onion.ExecutionMode" json:"execution_mode,omitempty"`
+	// max_gas caps the gas an onion tx may consume, the effective limit is the
+	// smaller of this value and the gas limit declared in the tx.
+	MaxGas uint64 `protobuf:"varint,2,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty"`
+	// min_gas_prices lists the fee denoms accepted for onion txs together with
+	// their minimum price per unit of gas. An empty list accepts any fee.
+	MinGasPrices []*v1beta1.DecCoin `protobuf:"bytes,3,rep,name=min_gas_prices,json=minGasPrices,proto3" json:"min_gas_prices,omitempty"`
+	// allowed_msg_types lists the message type URLs an onion tx may contain. A
+	// pattern ending in ".*" matches every type URL with that prefix, e.g.
+	// "/cosmos.bank.*". An empty list allows any message type. Messages nested
+	// in other messages, e.g. in an authz MsgExec, a gov or group proposal or
+	// an interchain account tx, must be allowed as well.
+	AllowedMsgTypes []string `protobuf:"bytes,4,rep,name=allowed_msg_types,json=allowedMsgTypes,proto3" json:"allowed_msg_types,omitempty"`
+	// denied_msg_types lists the message type URLs an onion tx must not
+	// contain, using the same patterns as allowed_msg_types. It takes
+	// precedence over allowed_msg_types.
+	DeniedMsgTypes []string `protobuf:"bytes,5,rep,name=denied_msg_types,json=deniedMsgTypes,proto3" json:"denied_msg_types,omitempty"`
+	// enabled_channels lists the channels on which received ICS-20 packets may
+	// carry an onion tx. Memos of packets received on any other channel are
+	// ignored, an empty list disables onion execution.
+	EnabledChannels []*EnabledChannel `protobuf:"bytes,6,rep,name=enabled_channels,json=enabledChannels,proto3" json:"enabled_channels,omitempty"`
+	// legacy_memo additionally accepts memos consisting of nothing but the
+	// base64 encoded onion tx. Without it only the JSON envelope
+	// {"onion":{"tx":"<base64>","version":1}} is recognized.
+	// Other text, such as the free text memo of a regular transfer, is
+	// ignored.
+	LegacyMemo bool `protobuf:"varint,7,opt,name=legacy_memo,json=legacyMemo,proto3" json:"legacy_memo,omitempty"`
+	// receipt_retention_blocks is the number of blocks an execution receipt is
+	// kept for before it is pruned. Zero disables execution receipts.
+	ReceiptRetentionBlocks uint64 `protobuf:"varint,8,opt,name=receipt_retention_blocks,json=receiptRetentionBlocks,proto3" json:"receipt_retention_blocks,omitempty"`
+	// retry_queue_blocks is the number of blocks an onion tx that failed
+	// during execution and opted in with ExtensionOptionRetry is kept in the
+	// retry queue for. Zero, the default, disables the retry queue.
+	RetryQueueBlocks uint64 `protobuf:"varint,9,opt,name=retry_queue_blocks,json=retryQueueBlocks,proto3" json:"retry_queue_blocks,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -410,6 +1754,114 @@ func (*Params) Descriptor() ([]byte, []int) {
 	return file_onion_onion_params_proto_rawDescGZIP(), []int{0}
 }
 
+func (x *Params) GetExecutionMode() ExecutionMode {
+	if x != nil {
+		return x.ExecutionMode
+	}
+	return ExecutionMode_EXECUTION_MODE_BEST_EFFORT
+}
+
+func (x *Params) GetMaxGas() uint64 {
+	if x != nil {
+		return x.MaxGas
+	}
+	return 0
+}
+
+func (x *Params) GetMinGasPrices() []*v1beta1.DecCoin {
+	if x != nil {
+		return x.MinGasPrices
+	}
+	return nil
+}
+
+func (x *Params) GetAllowedMsgTypes() []string {
+	if x != nil {
+		return x.AllowedMsgTypes
+	}
+	return nil
+}
+
+func (x *Params) GetDeniedMsgTypes() []string {
+	if x != nil {
+		return x.DeniedMsgTypes
+	}
+	return nil
+}
+
+func (x *Params) GetEnabledChannels() []*EnabledChannel {
+	if x != nil {
+		return x.EnabledChannels
+	}
+	return nil
+}
+
+func (x *Params) GetLegacyMemo() bool {
+	if x != nil {
+		return x.LegacyMemo
+	}
+	return false
+}
+
+func (x *Params) GetReceiptRetentionBlocks() uint64 {
+	if x != nil {
+		return x.ReceiptRetentionBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetRetryQueueBlocks() uint64 {
+	if x != nil {
+		return x.RetryQueueBlocks
+	}
+	return 0
+}
+
+// EnabledChannel identifies a channel end on this chain that accepts onion
+// txs.
+type EnabledChannel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (x *EnabledChannel) Reset() {
+	*x = EnabledChannel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_onion_onion_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnabledChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnabledChannel) ProtoMessage() {}
+
+// Deprecated: Use EnabledChannel.ProtoReflect.Descriptor instead.
+func (*EnabledChannel) Descriptor() ([]byte, []int) {
+	return file_onion_onion_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EnabledChannel) GetPortId() string {
+	if x != nil {
+		return x.PortId
+	}
+	return ""
+}
+
+func (x *EnabledChannel) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
 var File_onion_onion_params_proto protoreflect.FileDescriptor
 
 var file_onion_onion_params_proto_rawDesc = []byte{
@@ -418,18 +1870,63 @@ var file_onion_onion_params_proto_rawDesc = []byte{
 	0x6e, 0x2e, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61,
 	0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x27, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x3a, 0x1d, 0xe8, 0xa0, 0x1f, 0x01,
-	0x8a, 0xe7, 0xb0, 0x2a, 0x14, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2f, 0x6f, 0x6e, 0x69,
-	0x6f, 0x6e, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x89, 0x01, 0x0a, 0x0f, 0x63, 0x6f,
-	0x6d, 0x2e, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f,
-	0x6e, 0x69, 0x6f, 0x6e, 0x2f, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0xa2, 0x02, 0x03, 0x4f, 0x4f, 0x58,
-	0xaa, 0x02, 0x0b, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0xca, 0x02,
-	0x0b, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x5c, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0xe2, 0x02, 0x17, 0x4f,
-	0x6e, 0x69, 0x6f, 0x6e, 0x5c, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65,
-	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x3a, 0x3a,
-	0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xb3, 0x04, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x41, 0x0a, 0x0e, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x6f, 0x6e, 0x69, 0x6f,
+	0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x0d, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x17,
+	0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x6d, 0x61, 0x78, 0x47, 0x61, 0x73, 0x12, 0x7c, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x67,
+	0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x38, 0xc8,
+	0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69,
+	0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x12, 0x28, 0x0a, 0x10, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x65, 0x6e,
+	0x69, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x10, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x6f, 0x6e,
+	0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x4d, 0x65, 0x6d, 0x6f, 0x12,
+	0x38, 0x0a, 0x18, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x16, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x72, 0x65, 0x74, 0x72, 0x79, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x3a, 0x1d, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x14, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2f, 0x78, 0x2f, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2f,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x4e, 0x0a, 0x0e, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x72, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72, 0x74, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
+	0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x2a, 0x50, 0x0a, 0x0d, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x1a, 0x45, 0x58, 0x45, 0x43, 0x55,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x42, 0x45, 0x53, 0x54, 0x5f, 0x45,
+	0x46, 0x46, 0x4f, 0x52, 0x54, 0x10, 0x00, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x58, 0x45, 0x43, 0x55,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x54, 0x4f, 0x4d, 0x49, 0x43,
+	0x10, 0x01, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0x89, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d,
+	0x2e, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x6e,
+	0x69, 0x6f, 0x6e, 0x2f, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0xa2, 0x02, 0x03, 0x4f, 0x4f, 0x58, 0xaa,
+	0x02, 0x0b, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0xca, 0x02, 0x0b,
+	0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x5c, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0xe2, 0x02, 0x17, 0x4f, 0x6e,
+	0x69, 0x6f, 0x6e, 0x5c, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0c, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x4f,
+	0x6e, 0x69, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -444,16 +1941,23 @@ func file_onion_onion_params_proto_rawDescGZIP() []byte {
 	return file_onion_onion_params_proto_rawDescData
 }
 
-var file_onion_onion_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_onion_onion_params_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_onion_onion_params_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_onion_onion_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: onion.onion.Params
+	(ExecutionMode)(0),      // 0: onion.onion.ExecutionMode
+	(*Params)(nil),          // 1: onion.onion.Params
+	(*EnabledChannel)(nil),  // 2: onion.onion.EnabledChannel
+	(*v1beta1.DecCoin)(nil), // 3: cosmos.base.v1beta1.DecCoin
 }
 var file_onion_onion_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	0, // 0: onion.onion.Params.execution_mode:type_name -> onion.onion.ExecutionMode
+	3, // 1: onion.onion.Params.min_gas_prices:type_name -> cosmos.base.v1beta1.DecCoin
+	2, // 2: onion.onion.Params.enabled_channels:type_name -> onion.onion.EnabledChannel
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_onion_onion_params_proto_init() }
@@ -474,19 +1978,32 @@ func file_onion_onion_params_proto_init() {
 				return nil
 			}
 		}
+		file_onion_onion_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnabledChannel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_onion_onion_params_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_onion_onion_params_proto_goTypes,
 		DependencyIndexes: file_onion_onion_params_proto_depIdxs,
+		EnumInfos:         file_onion_onion_params_proto_enumTypes,
 		MessageInfos:      file_onion_onion_params_proto_msgTypes,
 	}.Build()
 	File_onion_onion_params_proto = out.File
@@ -19,8 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName   = "/onion.onion.Query/Params"
-	Query_Sequence_FullMethodName = "/onion.onion.Query/Sequence"
+	Query_Params_FullMethodName             = "/onion.onion.Query/Params"
+	Query_Sequence_FullMethodName           = "/onion.onion.Query/Sequence"
+	Query_Sequences_FullMethodName          = "/onion.onion.Query/Sequences"
+	Query_SimulateOnion_FullMethodName      = "/onion.onion.Query/SimulateOnion"
+	Query_EnabledChannels_FullMethodName    = "/onion.onion.Query/EnabledChannels"
+	Query_Execution_FullMethodName          = "/onion.onion.Query/Execution"
+	Query_ExecutionsBySigner_FullMethodName = "/onion.onion.Query/ExecutionsBySigner"
+	Query_QueuedTx_FullMethodName           = "/onion.onion.Query/QueuedTx"
+	Query_QueuedTxsBySigner_FullMethodName  = "/onion.onion.Query/QueuedTxsBySigner"
 )
 
 // QueryClient is the client API for Query service.
@@ -30,6 +37,24 @@ type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	Sequence(ctx context.Context, in *QuerySequenceRequest, opts ...grpc.CallOption) (*QuerySequenceResponse, error)
+	// Sequences lists the onion sequences of all addresses that have used
+	// onion txs.
+	Sequences(ctx context.Context, in *QuerySequencesRequest, opts ...grpc.CallOption) (*QuerySequencesResponse, error)
+	// SimulateOnion runs the onion tx of a memo on a discarded branch of the
+	// current state, as if it had arrived with an ICS-20 transfer.
+	SimulateOnion(ctx context.Context, in *QuerySimulateOnionRequest, opts ...grpc.CallOption) (*QuerySimulateOnionResponse, error)
+	// EnabledChannels queries the channels on which onion txs are executed.
+	EnabledChannels(ctx context.Context, in *QueryEnabledChannelsRequest, opts ...grpc.CallOption) (*QueryEnabledChannelsResponse, error)
+	// Execution queries the receipt of the onion tx carried by a received
+	// packet.
+	Execution(ctx context.Context, in *QueryExecutionRequest, opts ...grpc.CallOption) (*QueryExecutionResponse, error)
+	// ExecutionsBySigner lists the receipts of onion txs signed by an address.
+	ExecutionsBySigner(ctx context.Context, in *QueryExecutionsBySignerRequest, opts ...grpc.CallOption) (*QueryExecutionsBySignerResponse, error)
+	// QueuedTx queries an onion tx of the retry queue.
+	QueuedTx(ctx context.Context, in *QueryQueuedTxRequest, opts ...grpc.CallOption) (*QueryQueuedTxResponse, error)
+	// QueuedTxsBySigner lists the onion txs of the retry queue signed by an
+	// address.
+	QueuedTxsBySigner(ctx context.Context, in *QueryQueuedTxsBySignerRequest, opts ...grpc.CallOption) (*QueryQueuedTxsBySignerResponse, error)
 }
 
 type queryClient struct {
@@ -58,6 +83,69 @@ func (c *queryClient) Sequence(ctx context.Context, in *QuerySequenceRequest, op
 	return out, nil
 }
 
+func (c *queryClient) Sequences(ctx context.Context, in *QuerySequencesRequest, opts ...grpc.CallOption) (*QuerySequencesResponse, error) {
+	out := new(QuerySequencesResponse)
+	err := c.cc.Invoke(ctx, Query_Sequences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateOnion(ctx context.Context, in *QuerySimulateOnionRequest, opts ...grpc.CallOption) (*QuerySimulateOnionResponse, error) {
+	out := new(QuerySimulateOnionResponse)
+	err := c.cc.Invoke(ctx, Query_SimulateOnion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EnabledChannels(ctx context.Context, in *QueryEnabledChannelsRequest, opts ...grpc.CallOption) (*QueryEnabledChannelsResponse, error) {
+	out := new(QueryEnabledChannelsResponse)
+	err := c.cc.Invoke(ctx, Query_EnabledChannels_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Execution(ctx context.Context, in *QueryExecutionRequest, opts ...grpc.CallOption) (*QueryExecutionResponse, error) {
+	out := new(QueryExecutionResponse)
+	err := c.cc.Invoke(ctx, Query_Execution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExecutionsBySigner(ctx context.Context, in *QueryExecutionsBySignerRequest, opts ...grpc.CallOption) (*QueryExecutionsBySignerResponse, error) {
+	out := new(QueryExecutionsBySignerResponse)
+	err := c.cc.Invoke(ctx, Query_ExecutionsBySigner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedTx(ctx context.Context, in *QueryQueuedTxRequest, opts ...grpc.CallOption) (*QueryQueuedTxResponse, error) {
+	out := new(QueryQueuedTxResponse)
+	err := c.cc.Invoke(ctx, Query_QueuedTx_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedTxsBySigner(ctx context.Context, in *QueryQueuedTxsBySignerRequest, opts ...grpc.CallOption) (*QueryQueuedTxsBySignerResponse, error) {
+	out := new(QueryQueuedTxsBySignerResponse)
+	err := c.cc.Invoke(ctx, Query_QueuedTxsBySigner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -65,6 +153,24 @@ type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	Sequence(context.Context, *QuerySequenceRequest) (*QuerySequenceResponse, error)
+	// Sequences lists the onion sequences of all addresses that have used
+	// onion txs.
+	Sequences(context.Context, *QuerySequencesRequest) (*QuerySequencesResponse, error)
+	// SimulateOnion runs the onion tx of a memo on a discarded branch of the
+	// current state, as if it had arrived with an ICS-20 transfer.
+	SimulateOnion(context.Context, *QuerySimulateOnionRequest) (*QuerySimulateOnionResponse, error)
+	// EnabledChannels queries the channels on which onion txs are executed.
+	EnabledChannels(context.Context, *QueryEnabledChannelsRequest) (*QueryEnabledChannelsResponse, error)
+	// Execution queries the receipt of the onion tx carried by a received
+	// packet.
+	Execution(context.Context, *QueryExecutionRequest) (*QueryExecutionResponse, error)
+	// ExecutionsBySigner lists the receipts of onion txs signed by an address.
+	ExecutionsBySigner(context.Context, *QueryExecutionsBySignerRequest) (*QueryExecutionsBySignerResponse, error)
+	// QueuedTx queries an onion tx of the retry queue.
+	QueuedTx(context.Context, *QueryQueuedTxRequest) (*QueryQueuedTxResponse, error)
+	// QueuedTxsBySigner lists the onion txs of the retry queue signed by an
+	// address.
+	QueuedTxsBySigner(context.Context, *QueryQueuedTxsBySignerRequest) (*QueryQueuedTxsBySignerResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -78,6 +184,27 @@ func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*Q
 func (UnimplementedQueryServer) Sequence(context.Context, *QuerySequenceRequest) (*QuerySequenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Sequence not implemented")
 }
+func (UnimplementedQueryServer) Sequences(context.Context, *QuerySequencesRequest) (*QuerySequencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sequences not implemented")
+}
+func (UnimplementedQueryServer) SimulateOnion(context.Context, *QuerySimulateOnionRequest) (*QuerySimulateOnionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateOnion not implemented")
+}
+func (UnimplementedQueryServer) EnabledChannels(context.Context, *QueryEnabledChannelsRequest) (*QueryEnabledChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnabledChannels not implemented")
+}
+func (UnimplementedQueryServer) Execution(context.Context, *QueryExecutionRequest) (*QueryExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Execution not implemented")
+}
+func (UnimplementedQueryServer) ExecutionsBySigner(context.Context, *QueryExecutionsBySignerRequest) (*QueryExecutionsBySignerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutionsBySigner not implemented")
+}
+func (UnimplementedQueryServer) QueuedTx(context.Context, *QueryQueuedTxRequest) (*QueryQueuedTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedTx not implemented")
+}
+func (UnimplementedQueryServer) QueuedTxsBySigner(context.Context, *QueryQueuedTxsBySignerRequest) (*QueryQueuedTxsBySignerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedTxsBySigner not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -127,6 +254,132 @@ func _Query_Sequence_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Sequences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySequencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sequences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Sequences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sequences(ctx, req.(*QuerySequencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateOnion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateOnionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateOnion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulateOnion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateOnion(ctx, req.(*QuerySimulateOnionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EnabledChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEnabledChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EnabledChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EnabledChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EnabledChannels(ctx, req.(*QueryEnabledChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Execution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Execution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Execution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Execution(ctx, req.(*QueryExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecutionsBySigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutionsBySignerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutionsBySigner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExecutionsBySigner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutionsBySigner(ctx, req.(*QueryExecutionsBySignerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueuedTx_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedTx(ctx, req.(*QueryQueuedTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedTxsBySigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedTxsBySignerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedTxsBySigner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueuedTxsBySigner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedTxsBySigner(ctx, req.(*QueryQueuedTxsBySignerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -142,6 +395,34 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Sequence",
 			Handler:    _Query_Sequence_Handler,
 		},
+		{
+			MethodName: "Sequences",
+			Handler:    _Query_Sequences_Handler,
+		},
+		{
+			MethodName: "SimulateOnion",
+			Handler:    _Query_SimulateOnion_Handler,
+		},
+		{
+			MethodName: "EnabledChannels",
+			Handler:    _Query_EnabledChannels_Handler,
+		},
+		{
+			MethodName: "Execution",
+			Handler:    _Query_Execution_Handler,
+		},
+		{
+			MethodName: "ExecutionsBySigner",
+			Handler:    _Query_ExecutionsBySigner_Handler,
+		},
+		{
+			MethodName: "QueuedTx",
+			Handler:    _Query_QueuedTx_Handler,
+		},
+		{
+			MethodName: "QueuedTxsBySigner",
+			Handler:    _Query_QueuedTxsBySigner_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "onion/onion/query.proto",
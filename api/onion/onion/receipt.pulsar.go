@@ -0,0 +1,1446 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package onion
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_ExecutionReceipt_9_list)(nil)
+
+type _ExecutionReceipt_9_list struct {
+	list *[]string
+}
+
+func (x *_ExecutionReceipt_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ExecutionReceipt_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ExecutionReceipt_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ExecutionReceipt_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ExecutionReceipt_9_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ExecutionReceipt at list field Signers as it is not of Message kind"))
+}
+
+func (x *_ExecutionReceipt_9_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ExecutionReceipt_9_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ExecutionReceipt_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ExecutionReceipt_12_list)(nil)
+
+type _ExecutionReceipt_12_list struct {
+	list *[]*anypb.Any
+}
+
+func (x *_ExecutionReceipt_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ExecutionReceipt_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ExecutionReceipt_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ExecutionReceipt_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*anypb.Any)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ExecutionReceipt_12_list) AppendMutable() protoreflect.Value {
+	v := new(anypb.Any)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ExecutionReceipt_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ExecutionReceipt_12_list) NewElement() protoreflect.Value {
+	v := new(anypb.Any)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ExecutionReceipt_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ExecutionReceipt                 protoreflect.MessageDescriptor
+	fd_ExecutionReceipt_port_id         protoreflect.FieldDescriptor
+	fd_ExecutionReceipt_channel_id      protoreflect.FieldDescriptor
+	fd_ExecutionReceipt_packet_sequence protoreflect.FieldDescriptor
+	fd_ExecutionReceipt_height          protoreflect.FieldDescriptor
+	fd_ExecutionReceipt_success         protoreflect.FieldDescriptor
+	fd_ExecutionReceipt_failed_stage    protoreflect.FieldDescriptor
+	fd_ExecutionReceipt_error_code      protoreflect.FieldDescriptor
+	fd_ExecutionReceipt_error           protoreflect.FieldDescriptor
+	fd_ExecutionReceipt_signers         protoreflect.FieldDescriptor
+	fd_ExecutionReceipt_tx_hash         protoreflect.FieldDescriptor
+	fd_ExecutionReceipt_gas_used        protoreflect.FieldDescriptor
+	fd_ExecutionReceipt_msg_responses   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_onion_onion_receipt_proto_init()
+	md_ExecutionReceipt = File_onion_onion_receipt_proto.Messages().ByName("ExecutionReceipt")
+	fd_ExecutionReceipt_port_id = md_ExecutionReceipt.Fields().ByName("port_id")
+	fd_ExecutionReceipt_channel_id = md_ExecutionReceipt.Fields().ByName("channel_id")
+	fd_ExecutionReceipt_packet_sequence = md_ExecutionReceipt.Fields().ByName("packet_sequence")
+	fd_ExecutionReceipt_height = md_ExecutionReceipt.Fields().ByName("height")
+	fd_ExecutionReceipt_success = md_ExecutionReceipt.Fields().ByName("success")
+	fd_ExecutionReceipt_failed_stage = md_ExecutionReceipt.Fields().ByName("failed_stage")
+	fd_ExecutionReceipt_error_code = md_ExecutionReceipt.Fields().ByName("error_code")
+	fd_ExecutionReceipt_error = md_ExecutionReceipt.Fields().ByName("error")
+	fd_ExecutionReceipt_signers = md_ExecutionReceipt.Fields().ByName("signers")
+	fd_ExecutionReceipt_tx_hash = md_ExecutionReceipt.Fields().ByName("tx_hash")
+	fd_ExecutionReceipt_gas_used = md_ExecutionReceipt.Fields().ByName("gas_used")
+	fd_ExecutionReceipt_msg_responses = md_ExecutionReceipt.Fields().ByName("msg_responses")
+}
+
+var _ protoreflect.Message = (*fastReflection_ExecutionReceipt)(nil)
+
+type fastReflection_ExecutionReceipt ExecutionReceipt
+
+func (x *ExecutionReceipt) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ExecutionReceipt)(x)
+}
+
+func (x *ExecutionReceipt) slowProtoReflect() protoreflect.Message {
+	mi := &file_onion_onion_receipt_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ExecutionReceipt_messageType fastReflection_ExecutionReceipt_messageType
+var _ protoreflect.MessageType = fastReflection_ExecutionReceipt_messageType{}
+
+type fastReflection_ExecutionReceipt_messageType struct{}
+
+func (x fastReflection_ExecutionReceipt_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ExecutionReceipt)(nil)
+}
+func (x fastReflection_ExecutionReceipt_messageType) New() protoreflect.Message {
+	return new(fastReflection_ExecutionReceipt)
+}
+func (x fastReflection_ExecutionReceipt_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExecutionReceipt
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ExecutionReceipt) Descriptor() protoreflect.MessageDescriptor {
+	return md_ExecutionReceipt
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ExecutionReceipt) Type() protoreflect.MessageType {
+	return _fastReflection_ExecutionReceipt_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ExecutionReceipt) New() protoreflect.Message {
+	return new(fastReflection_ExecutionReceipt)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ExecutionReceipt) Interface() protoreflect.ProtoMessage {
+	return (*ExecutionReceipt)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ExecutionReceipt) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PortId != "" {
+		value := protoreflect.ValueOfString(x.PortId)
+		if !f(fd_ExecutionReceipt_port_id, value) {
+			return
+		}
+	}
+	if x.ChannelId != "" {
+		value := protoreflect.ValueOfString(x.ChannelId)
+		if !f(fd_ExecutionReceipt_channel_id, value) {
+			return
+		}
+	}
+	if x.PacketSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PacketSequence)
+		if !f(fd_ExecutionReceipt_packet_sequence, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_ExecutionReceipt_height, value) {
+			return
+		}
+	}
+	if x.Success != false {
+		value := protoreflect.ValueOfBool(x.Success)
+		if !f(fd_ExecutionReceipt_success, value) {
+			return
+		}
+	}
+	if x.FailedStage != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.FailedStage))
+		if !f(fd_ExecutionReceipt_failed_stage, value) {
+			return
+		}
+	}
+	if x.ErrorCode != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.ErrorCode)
+		if !f(fd_ExecutionReceipt_error_code, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_ExecutionReceipt_error, value) {
+			return
+		}
+	}
+	if len(x.Signers) != 0 {
+		value := protoreflect.ValueOfList(&_ExecutionReceipt_9_list{list: &x.Signers})
+		if !f(fd_ExecutionReceipt_signers, value) {
+			return
+		}
+	}
+	if x.TxHash != "" {
+		value := protoreflect.ValueOfString(x.TxHash)
+		if !f(fd_ExecutionReceipt_tx_hash, value) {
+			return
+		}
+	}
+	if x.GasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasUsed)
+		if !f(fd_ExecutionReceipt_gas_used, value) {
+			return
+		}
+	}
+	if len(x.MsgResponses) != 0 {
+		value := protoreflect.ValueOfList(&_ExecutionReceipt_12_list{list: &x.MsgResponses})
+		if !f(fd_ExecutionReceipt_msg_responses, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ExecutionReceipt) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "onion.onion.ExecutionReceipt.port_id":
+		return x.PortId != ""
+	case "onion.onion.ExecutionReceipt.channel_id":
+		return x.ChannelId != ""
+	case "onion.onion.ExecutionReceipt.packet_sequence":
+		return x.PacketSequence != uint64(0)
+	case "onion.onion.ExecutionReceipt.height":
+		return x.Height != int64(0)
+	case "onion.onion.ExecutionReceipt.success":
+		return x.Success != false
+	case "onion.onion.ExecutionReceipt.failed_stage":
+		return x.FailedStage != 0
+	case "onion.onion.ExecutionReceipt.error_code":
+		return x.ErrorCode != uint32(0)
+	case "onion.onion.ExecutionReceipt.error":
+		return x.Error != ""
+	case "onion.onion.ExecutionReceipt.signers":
+		return len(x.Signers) != 0
+	case "onion.onion.ExecutionReceipt.tx_hash":
+		return x.TxHash != ""
+	case "onion.onion.ExecutionReceipt.gas_used":
+		return x.GasUsed != uint64(0)
+	case "onion.onion.ExecutionReceipt.msg_responses":
+		return len(x.MsgResponses) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.ExecutionReceipt"))
+		}
+		panic(fmt.Errorf("message onion.onion.ExecutionReceipt does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutionReceipt) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "onion.onion.ExecutionReceipt.port_id":
+		x.PortId = ""
+	case "onion.onion.ExecutionReceipt.channel_id":
+		x.ChannelId = ""
+	case "onion.onion.ExecutionReceipt.packet_sequence":
+		x.PacketSequence = uint64(0)
+	case "onion.onion.ExecutionReceipt.height":
+		x.Height = int64(0)
+	case "onion.onion.ExecutionReceipt.success":
+		x.Success = false
+	case "onion.onion.ExecutionReceipt.failed_stage":
+		x.FailedStage = 0
+	case "onion.onion.ExecutionReceipt.error_code":
+		x.ErrorCode = uint32(0)
+	case "onion.onion.ExecutionReceipt.error":
+		x.Error = ""
+	case "onion.onion.ExecutionReceipt.signers":
+		x.Signers = nil
+	case "onion.onion.ExecutionReceipt.tx_hash":
+		x.TxHash = ""
+	case "onion.onion.ExecutionReceipt.gas_used":
+		x.GasUsed = uint64(0)
+	case "onion.onion.ExecutionReceipt.msg_responses":
+		x.MsgResponses = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.ExecutionReceipt"))
+		}
+		panic(fmt.Errorf("message onion.onion.ExecutionReceipt does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ExecutionReceipt) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "onion.onion.ExecutionReceipt.port_id":
+		value := x.PortId
+		return protoreflect.ValueOfString(value)
+	case "onion.onion.ExecutionReceipt.channel_id":
+		value := x.ChannelId
+		return protoreflect.ValueOfString(value)
+	case "onion.onion.ExecutionReceipt.packet_sequence":
+		value := x.PacketSequence
+		return protoreflect.ValueOfUint64(value)
+	case "onion.onion.ExecutionReceipt.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "onion.onion.ExecutionReceipt.success":
+		value := x.Success
+		return protoreflect.ValueOfBool(value)
+	case "onion.onion.ExecutionReceipt.failed_stage":
+		value := x.FailedStage
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "onion.onion.ExecutionReceipt.error_code":
+		value := x.ErrorCode
+		return protoreflect.ValueOfUint32(value)
+	case "onion.onion.ExecutionReceipt.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	case "onion.onion.ExecutionReceipt.signers":
+		if len(x.Signers) == 0 {
+			return protoreflect.ValueOfList(&_ExecutionReceipt_9_list{})
+		}
+		listValue := &_ExecutionReceipt_9_list{list: &x.Signers}
+		return protoreflect.ValueOfList(listValue)
+	case "onion.onion.ExecutionReceipt.tx_hash":
+		value := x.TxHash
+		return protoreflect.ValueOfString(value)
+	case "onion.onion.ExecutionReceipt.gas_used":
+		value := x.GasUsed
+		return protoreflect.ValueOfUint64(value)
+	case "onion.onion.ExecutionReceipt.msg_responses":
+		if len(x.MsgResponses) == 0 {
+			return protoreflect.ValueOfList(&_ExecutionReceipt_12_list{})
+		}
+		listValue := &_ExecutionReceipt_12_list{list: &x.MsgResponses}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.ExecutionReceipt"))
+		}
+		panic(fmt.Errorf("message onion.onion.ExecutionReceipt does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutionReceipt) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "onion.onion.ExecutionReceipt.port_id":
+		x.PortId = value.Interface().(string)
+	case "onion.onion.ExecutionReceipt.channel_id":
+		x.ChannelId = value.Interface().(string)
+	case "onion.onion.ExecutionReceipt.packet_sequence":
+		x.PacketSequence = value.Uint()
+	case "onion.onion.ExecutionReceipt.height":
+		x.Height = value.Int()
+	case "onion.onion.ExecutionReceipt.success":
+		x.Success = value.Bool()
+	case "onion.onion.ExecutionReceipt.failed_stage":
+		x.FailedStage = (ExecutionStage)(value.Enum())
+	case "onion.onion.ExecutionReceipt.error_code":
+		x.ErrorCode = uint32(value.Uint())
+	case "onion.onion.ExecutionReceipt.error":
+		x.Error = value.Interface().(string)
+	case "onion.onion.ExecutionReceipt.signers":
+		lv := value.List()
+		clv := lv.(*_ExecutionReceipt_9_list)
+		x.Signers = *clv.list
+	case "onion.onion.ExecutionReceipt.tx_hash":
+		x.TxHash = value.Interface().(string)
+	case "onion.onion.ExecutionReceipt.gas_used":
+		x.GasUsed = value.Uint()
+	case "onion.onion.ExecutionReceipt.msg_responses":
+		lv := value.List()
+		clv := lv.(*_ExecutionReceipt_12_list)
+		x.MsgResponses = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.ExecutionReceipt"))
+		}
+		panic(fmt.Errorf("message onion.onion.ExecutionReceipt does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutionReceipt) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "onion.onion.ExecutionReceipt.signers":
+		if x.Signers == nil {
+			x.Signers = []string{}
+		}
+		value := &_ExecutionReceipt_9_list{list: &x.Signers}
+		return protoreflect.ValueOfList(value)
+	case "onion.onion.ExecutionReceipt.msg_responses":
+		if x.MsgResponses == nil {
+			x.MsgResponses = []*anypb.Any{}
+		}
+		value := &_ExecutionReceipt_12_list{list: &x.MsgResponses}
+		return protoreflect.ValueOfList(value)
+	case "onion.onion.ExecutionReceipt.port_id":
+		panic(fmt.Errorf("field port_id of message onion.onion.ExecutionReceipt is not mutable"))
+	case "onion.onion.ExecutionReceipt.channel_id":
+		panic(fmt.Errorf("field channel_id of message onion.onion.ExecutionReceipt is not mutable"))
+	case "onion.onion.ExecutionReceipt.packet_sequence":
+		panic(fmt.Errorf("field packet_sequence of message onion.onion.ExecutionReceipt is not mutable"))
+	case "onion.onion.ExecutionReceipt.height":
+		panic(fmt.Errorf("field height of message onion.onion.ExecutionReceipt is not mutable"))
+	case "onion.onion.ExecutionReceipt.success":
+		panic(fmt.Errorf("field success of message onion.onion.ExecutionReceipt is not mutable"))
+	case "onion.onion.ExecutionReceipt.failed_stage":
+		panic(fmt.Errorf("field failed_stage of message onion.onion.ExecutionReceipt is not mutable"))
+	case "onion.onion.ExecutionReceipt.error_code":
+		panic(fmt.Errorf("field error_code of message onion.onion.ExecutionReceipt is not mutable"))
+	case "onion.onion.ExecutionReceipt.error":
+		panic(fmt.Errorf("field error of message onion.onion.ExecutionReceipt is not mutable"))
+	case "onion.onion.ExecutionReceipt.tx_hash":
+		panic(fmt.Errorf("field tx_hash of message onion.onion.ExecutionReceipt is not mutable"))
+	case "onion.onion.ExecutionReceipt.gas_used":
+		panic(fmt.Errorf("field gas_used of message onion.onion.ExecutionReceipt is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.ExecutionReceipt"))
+		}
+		panic(fmt.Errorf("message onion.onion.ExecutionReceipt does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ExecutionReceipt) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "onion.onion.ExecutionReceipt.port_id":
+		return protoreflect.ValueOfString("")
+	case "onion.onion.ExecutionReceipt.channel_id":
+		return protoreflect.ValueOfString("")
+	case "onion.onion.ExecutionReceipt.packet_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "onion.onion.ExecutionReceipt.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "onion.onion.ExecutionReceipt.success":
+		return protoreflect.ValueOfBool(false)
+	case "onion.onion.ExecutionReceipt.failed_stage":
+		return protoreflect.ValueOfEnum(0)
+	case "onion.onion.ExecutionReceipt.error_code":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "onion.onion.ExecutionReceipt.error":
+		return protoreflect.ValueOfString("")
+	case "onion.onion.ExecutionReceipt.signers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ExecutionReceipt_9_list{list: &list})
+	case "onion.onion.ExecutionReceipt.tx_hash":
+		return protoreflect.ValueOfString("")
+	case "onion.onion.ExecutionReceipt.gas_used":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "onion.onion.ExecutionReceipt.msg_responses":
+		list := []*anypb.Any{}
+		return protoreflect.ValueOfList(&_ExecutionReceipt_12_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: onion.onion.ExecutionReceipt"))
+		}
+		panic(fmt.Errorf("message onion.onion.ExecutionReceipt does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ExecutionReceipt) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in onion.onion.ExecutionReceipt", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ExecutionReceipt) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ExecutionReceipt) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ExecutionReceipt) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ExecutionReceipt) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ExecutionReceipt)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.PortId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ChannelId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PacketSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.PacketSequence))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Success {
+			n += 2
+		}
+		if x.FailedStage != 0 {
+			n += 1 + runtime.Sov(uint64(x.FailedStage))
+		}
+		if x.ErrorCode != 0 {
+			n += 1 + runtime.Sov(uint64(x.ErrorCode))
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Signers) > 0 {
+			for _, s := range x.Signers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.TxHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GasUsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasUsed))
+		}
+		if len(x.MsgResponses) > 0 {
+			for _, e := range x.MsgResponses {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ExecutionReceipt)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MsgResponses) > 0 {
+			for iNdEx := len(x.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MsgResponses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.GasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasUsed))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.TxHash) > 0 {
+			i -= len(x.TxHash)
+			copy(dAtA[i:], x.TxHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxHash)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.Signers) > 0 {
+			for iNdEx := len(x.Signers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Signers[iNdEx])
+				copy(dAtA[i:], x.Signers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Signers[iNdEx])))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.ErrorCode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ErrorCode))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.FailedStage != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FailedStage))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Success {
+			i--
+			if x.Success {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.PacketSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PacketSequence))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.ChannelId) > 0 {
+			i -= len(x.ChannelId)
+			copy(dAtA[i:], x.ChannelId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChannelId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.PortId) > 0 {
+			i -= len(x.PortId)
+			copy(dAtA[i:], x.PortId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PortId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ExecutionReceipt)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExecutionReceipt: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ExecutionReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PortId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChannelId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PacketSequence", wireType)
+				}
+				x.PacketSequence = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PacketSequence |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Success = bool(v != 0)
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailedStage", wireType)
+				}
+				x.FailedStage = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FailedStage |= ExecutionStage(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
+				}
+				x.ErrorCode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ErrorCode |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Signers = append(x.Signers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TxHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+				}
+				x.GasUsed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasUsed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgResponses", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgResponses = append(x.MsgResponses, &anypb.Any{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MsgResponses[len(x.MsgResponses)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: onion/onion/receipt.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ExecutionReceipt records the outcome of the onion tx carried by a received
+// ICS-20 packet. Receipts are kept for Params.receipt_retention_blocks
+// blocks. In atomic mode a failed execution reverts the receipt along with
+// the transfer, the error acknowledgement is the only record of it then.
+type ExecutionReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// port_id, channel_id and packet_sequence identify the packet on this
+	// chain's end of the channel.
+	PortId         string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId      string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	PacketSequence uint64 `protobuf:"varint,3,opt,name=packet_sequence,json=packetSequence,proto3" json:"packet_sequence,omitempty"`
+	// height is the block height the packet was received at.
+	Height  int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Success bool  `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// failed_stage is unspecified when the execution succeeded.
+	FailedStage ExecutionStage `protobuf:"varint,6,opt,name=failed_stage,json=failedStage,proto3,enum=onion.onion.ExecutionStage" json:"failed_stage,omitempty"`
+	// error_code is the ABCI code of the error within the onion codespace.
+	ErrorCode uint32 `protobuf:"varint,7,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	Error     string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// signers of the onion tx, empty if the memo could not be decoded.
+	Signers []string `protobuf:"bytes,9,rep,name=signers,proto3" json:"signers,omitempty"`
+	// tx_hash is the hex encoded hash of the onion tx bytes.
+	TxHash  string `protobuf:"bytes,10,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	GasUsed uint64 `protobuf:"varint,11,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// msg_responses holds the responses of the tx messages when the execution
+	// succeeded.
+	MsgResponses []*anypb.Any `protobuf:"bytes,12,rep,name=msg_responses,json=msgResponses,proto3" json:"msg_responses,omitempty"`
+}
+
+func (x *ExecutionReceipt) Reset() {
+	*x = ExecutionReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_onion_onion_receipt_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecutionReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionReceipt) ProtoMessage() {}
+
+// Deprecated: Use ExecutionReceipt.ProtoReflect.Descriptor instead.
+func (*ExecutionReceipt) Descriptor() ([]byte, []int) {
+	return file_onion_onion_receipt_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExecutionReceipt) GetPortId() string {
+	if x != nil {
+		return x.PortId
+	}
+	return ""
+}
+
+func (x *ExecutionReceipt) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ExecutionReceipt) GetPacketSequence() uint64 {
+	if x != nil {
+		return x.PacketSequence
+	}
+	return 0
+}
+
+func (x *ExecutionReceipt) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ExecutionReceipt) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ExecutionReceipt) GetFailedStage() ExecutionStage {
+	if x != nil {
+		return x.FailedStage
+	}
+	return ExecutionStage_EXECUTION_STAGE_UNSPECIFIED
+}
+
+func (x *ExecutionReceipt) GetErrorCode() uint32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *ExecutionReceipt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ExecutionReceipt) GetSigners() []string {
+	if x != nil {
+		return x.Signers
+	}
+	return nil
+}
+
+func (x *ExecutionReceipt) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *ExecutionReceipt) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *ExecutionReceipt) GetMsgResponses() []*anypb.Any {
+	if x != nil {
+		return x.MsgResponses
+	}
+	return nil
+}
+
+var File_onion_onion_receipt_proto protoreflect.FileDescriptor
+
+var file_onion_onion_receipt_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2f, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2f, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x6f, 0x6e, 0x69,
+	0x6f, 0x6e, 0x2e, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2f, 0x6f, 0x6e, 0x69, 0x6f, 0x6e,
+	0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa3, 0x03,
+	0x0a, 0x10, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61,
+	0x63, 0x6b, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f,
+	0x73, 0x74, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x6f, 0x6e,
+	0x69, 0x6f, 0x6e, 0x2e, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64,
+	0x53, 0x74, 0x61, 0x67, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63,
+	0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x19, 0x0a,
+	0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0d, 0x6d, 0x73, 0x67, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x0c, 0x6d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x73, 0x42, 0x8a, 0x01, 0x0a, 0x0f, 0x63, 0x6f, 0x6d, 0x2e, 0x6f, 0x6e, 0x69, 0x6f,
+	0x6e, 0x2e, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1c, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0x2f,
+	0x6f, 0x6e, 0x69, 0x6f, 0x6e, 0xa2, 0x02, 0x03, 0x4f, 0x4f, 0x58, 0xaa, 0x02, 0x0b, 0x4f, 0x6e,
+	0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0xca, 0x02, 0x0b, 0x4f, 0x6e, 0x69, 0x6f,
+	0x6e, 0x5c, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0xe2, 0x02, 0x17, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x5c,
+	0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x0c, 0x4f, 0x6e, 0x69, 0x6f, 0x6e, 0x3a, 0x3a, 0x4f, 0x6e, 0x69, 0x6f, 0x6e,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_onion_onion_receipt_proto_rawDescOnce sync.Once
+	file_onion_onion_receipt_proto_rawDescData = file_onion_onion_receipt_proto_rawDesc
+)
+
+func file_onion_onion_receipt_proto_rawDescGZIP() []byte {
+	file_onion_onion_receipt_proto_rawDescOnce.Do(func() {
+		file_onion_onion_receipt_proto_rawDescData = protoimpl.X.CompressGZIP(file_onion_onion_receipt_proto_rawDescData)
+	})
+	return file_onion_onion_receipt_proto_rawDescData
+}
+
+var file_onion_onion_receipt_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_onion_onion_receipt_proto_goTypes = []interface{}{
+	(*ExecutionReceipt)(nil), // 0: onion.onion.ExecutionReceipt
+	(ExecutionStage)(0),      // 1: onion.onion.ExecutionStage
+	(*anypb.Any)(nil),        // 2: google.protobuf.Any
+}
+var file_onion_onion_receipt_proto_depIdxs = []int32{
+	1, // 0: onion.onion.ExecutionReceipt.failed_stage:type_name -> onion.onion.ExecutionStage
+	2, // 1: onion.onion.ExecutionReceipt.msg_responses:type_name -> google.protobuf.Any
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_onion_onion_receipt_proto_init() }
+func file_onion_onion_receipt_proto_init() {
+	if File_onion_onion_receipt_proto != nil {
+		return
+	}
+	file_onion_onion_events_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_onion_onion_receipt_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExecutionReceipt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_onion_onion_receipt_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_onion_onion_receipt_proto_goTypes,
+		DependencyIndexes: file_onion_onion_receipt_proto_depIdxs,
+		MessageInfos:      file_onion_onion_receipt_proto_msgTypes,
+	}.Build()
+	File_onion_onion_receipt_proto = out.File
+	file_onion_onion_receipt_proto_rawDesc = nil
+	file_onion_onion_receipt_proto_goTypes = nil
+	file_onion_onion_receipt_proto_depIdxs = nil
+}
@@ -20,6 +20,8 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	Msg_UpdateParams_FullMethodName = "/onion.onion.Msg/UpdateParams"
+	Msg_ExecuteOnion_FullMethodName = "/onion.onion.Msg/ExecuteOnion"
+	Msg_RetryOnion_FullMethodName   = "/onion.onion.Msg/RetryOnion"
 )
 
 // MsgClient is the client API for Msg service.
@@ -29,6 +31,11 @@ type MsgClient interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ExecuteOnion executes a signed onion tx without an ICS-20 packet, e.g.
+	// when the transfer carrying it was never sent or timed out.
+	ExecuteOnion(ctx context.Context, in *MsgExecuteOnion, opts ...grpc.CallOption) (*MsgExecuteOnionResponse, error)
+	// RetryOnion executes an onion tx of the retry queue again.
+	RetryOnion(ctx context.Context, in *MsgRetryOnion, opts ...grpc.CallOption) (*MsgRetryOnionResponse, error)
 }
 
 type msgClient struct {
@@ -48,6 +55,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ExecuteOnion(ctx context.Context, in *MsgExecuteOnion, opts ...grpc.CallOption) (*MsgExecuteOnionResponse, error) {
+	out := new(MsgExecuteOnionResponse)
+	err := c.cc.Invoke(ctx, Msg_ExecuteOnion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RetryOnion(ctx context.Context, in *MsgRetryOnion, opts ...grpc.CallOption) (*MsgRetryOnionResponse, error) {
+	out := new(MsgRetryOnionResponse)
+	err := c.cc.Invoke(ctx, Msg_RetryOnion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -55,6 +80,11 @@ type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ExecuteOnion executes a signed onion tx without an ICS-20 packet, e.g.
+	// when the transfer carrying it was never sent or timed out.
+	ExecuteOnion(context.Context, *MsgExecuteOnion) (*MsgExecuteOnionResponse, error)
+	// RetryOnion executes an onion tx of the retry queue again.
+	RetryOnion(context.Context, *MsgRetryOnion) (*MsgRetryOnionResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -65,6 +95,12 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) ExecuteOnion(context.Context, *MsgExecuteOnion) (*MsgExecuteOnionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteOnion not implemented")
+}
+func (UnimplementedMsgServer) RetryOnion(context.Context, *MsgRetryOnion) (*MsgRetryOnionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryOnion not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -96,6 +132,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ExecuteOnion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteOnion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteOnion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ExecuteOnion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteOnion(ctx, req.(*MsgExecuteOnion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetryOnion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryOnion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryOnion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RetryOnion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryOnion(ctx, req.(*MsgRetryOnion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -107,6 +179,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ExecuteOnion",
+			Handler:    _Msg_ExecuteOnion_Handler,
+		},
+		{
+			MethodName: "RetryOnion",
+			Handler:    _Msg_RetryOnion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "onion/onion/tx.proto",
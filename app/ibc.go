@@ -34,6 +34,8 @@ import (
 	solomachine "github.com/cosmos/ibc-go/v8/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 
+	onionmodule "onion/x/onion/module"
+
 	// this line is used by starport scaffolding # ibc/app/import
 )
 
@@ -112,6 +114,7 @@ func (app *App) registerIBCModules() {
 		scopedIBCTransferKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	app.OnionKeeper.SetTransferKeepers(app.IBCKeeper.ChannelKeeper, app.TransferKeeper)
 
 	// Create interchain account keepers
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
@@ -139,8 +142,12 @@ func (app *App) registerIBCModules() {
 	)
 	app.GovKeeper.SetLegacyRouter(govRouter)
 
-	// Create IBC modules with ibcfee middleware
-	transferIBCModule := ibcfee.NewIBCMiddleware(ibctransfer.NewIBCModule(app.TransferKeeper), app.IBCFeeKeeper)
+	// Create IBC modules with ibcfee middleware, the onion middleware executes
+	// memo-carried txs once the wrapped transfer module has credited the funds
+	var transferIBCModule porttypes.IBCModule
+	transferIBCModule = ibctransfer.NewIBCModule(app.TransferKeeper)
+	transferIBCModule = onionmodule.NewIBCModule(transferIBCModule, app.OnionKeeper, app.txConfig)
+	transferIBCModule = ibcfee.NewIBCMiddleware(transferIBCModule, app.IBCFeeKeeper)
 
 	// integration point for custom authentication modules
 	var noAuthzModule porttypes.IBCModule
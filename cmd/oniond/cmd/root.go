@@ -25,6 +25,7 @@ import (
 	"github.com/spf13/pflag"
 
 	"onion/app"
+	onioncli "onion/x/onion/client/cli"
 )
 
 // NewRootCmd creates a new root command for oniond. It is called once in the main function.
@@ -125,6 +126,13 @@ func NewRootCmd() *cobra.Command {
 		panic(err)
 	}
 
+	// the module tx commands only exist once autocli added them
+	for _, cmd := range rootCmd.Commands() {
+		if cmd.Name() == "tx" {
+			onioncli.AddOnionFlag(cmd)
+		}
+	}
+
 	return rootCmd
 }
 
@@ -0,0 +1,331 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"onion/x/onion/keeper"
+	"onion/x/onion/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// FlagReceiver sets the receiver of the transfer carrying the memo, decode-memo
+// checks the onion tx as if it delivered --funds to it.
+const FlagReceiver = "receiver"
+
+// decodedMemo is the output of decode-memo.
+type decodedMemo struct {
+	// Format is "json" for the onion envelope and "legacy" for a raw base64
+	// tx.
+	Format       string          `json:"format"`
+	MemoDeadline *time.Time      `json:"memo_deadline,omitempty"`
+	Signers      []decodedSigner `json:"signers"`
+	Unordered    bool            `json:"unordered"`
+	Tx           json.RawMessage `json:"tx"`
+	// ChainID and Checks are only set when the memo is checked against a
+	// node.
+	ChainID string         `json:"chain_id,omitempty"`
+	Checks  []onionTxCheck `json:"checks,omitempty"`
+	// FailedCheck names the first check that fails, the onion tx is rejected
+	// there.
+	FailedCheck keeper.OnionTxCheck `json:"failed_check,omitempty"`
+}
+
+type decodedSigner struct {
+	Address  string `json:"address"`
+	SignMode string `json:"sign_mode"`
+	// Sequence is the onion sequence, or the unordered nonce, the signature
+	// claims.
+	Sequence uint64 `json:"sequence"`
+	// OnionSequence is the current onion sequence of the signer on chain.
+	OnionSequence *uint64 `json:"onion_sequence,omitempty"`
+}
+
+type onionTxCheck struct {
+	Check keeper.OnionTxCheck `json:"check"`
+	// Result is "passed", "failed" or "skipped" for checks after a failed
+	// one or checks that cannot be done against the node.
+	Result string `json:"result"`
+	Error  string `json:"error,omitempty"`
+}
+
+// NewDecodeMemoCmd returns a CLI command handler that decodes the onion tx of
+// a memo and checks it against the chain.
+func NewDecodeMemoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-memo [memo]",
+		Short: "Decode the onion tx of a memo and check it against the chain",
+		Long: `Decode the onion tx carried by an ICS-20 memo, either the JSON envelope or a raw
+base64 tx, and print it as JSON with its signers, sign modes and claimed
+sequences.
+
+Unless --offline is set, the memo is also checked against the node as if it
+was received in a packet now, with the checks the chain runs before the
+messages of the onion tx, in the same order. The first failing check is
+reported as failed_check. The balance of the fee payer is not checked as the
+packet delivers funds. Onion txs bound to the packet or filling in the
+received amount need --funds and --receiver, they fail those checks otherwise
+as they would when not carried by an ICS-20 packet.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			parsed, found, err := types.ParseMemo(args[0], true)
+			if err != nil {
+				return err
+			}
+			if !found {
+				return errors.New("memo does not carry an onion tx")
+			}
+			tx, err := clientCtx.TxConfig.TxDecoder()(parsed.TxBytes)
+			if err != nil {
+				return errorsmod.Wrap(types.ErrTxDecode, err.Error())
+			}
+
+			decoded, err := decodeOnionTx(clientCtx, tx)
+			if err != nil {
+				return err
+			}
+			decoded.Format = "legacy"
+			if strings.HasPrefix(strings.TrimSpace(args[0]), "{") {
+				decoded.Format = "json"
+			}
+			decoded.MemoDeadline = parsed.Deadline
+
+			if offline, _ := cmd.Flags().GetBool(flags.FlagOffline); !offline {
+				funds, err := packetFunds(cmd)
+				if err != nil {
+					return err
+				}
+				if err := checkOnionTx(cmd.Context(), clientCtx, tx, funds, &decoded); err != nil {
+					return err
+				}
+			}
+
+			bz, err := json.MarshalIndent(decoded, "", "  ")
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", bz))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Bool(flags.FlagOffline, false, "Only decode the memo, do not check it against the node")
+	cmd.Flags().String(FlagFunds, "", "Coin the transfer carrying the memo delivers, requires --receiver")
+	cmd.Flags().String(FlagReceiver, "", "Receiver of the transfer carrying the memo")
+
+	return cmd
+}
+
+// decodeOnionTx returns tx as JSON along with its signers.
+func decodeOnionTx(clientCtx client.Context, tx sdk.Tx) (decodedMemo, error) {
+	txJSON, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
+	if err != nil {
+		return decodedMemo{}, err
+	}
+	decoded := decodedMemo{
+		Tx:        txJSON,
+		Unordered: keeper.IsUnorderedTx(tx),
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return decoded, fmt.Errorf("expected tx to implement SigVerifiableTx, got %T", tx)
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return decoded, err
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return decoded, err
+	}
+	for i, signer := range signers {
+		decodedSigner := decodedSigner{Address: sdk.AccAddress(signer).String()}
+		if i < len(sigs) {
+			decodedSigner.SignMode = signModeString(sigs[i].Data)
+			decodedSigner.Sequence = sigs[i].Sequence
+		}
+		decoded.Signers = append(decoded.Signers, decodedSigner)
+	}
+	return decoded, nil
+}
+
+// packetFunds returns the funds set with --funds and --receiver, or nil if
+// they are not set.
+func packetFunds(cmd *cobra.Command) (*types.ReceivedFunds, error) {
+	fundsStr, _ := cmd.Flags().GetString(FlagFunds)
+	receiver, _ := cmd.Flags().GetString(FlagReceiver)
+	if fundsStr == "" && receiver == "" {
+		return nil, nil
+	}
+	if fundsStr == "" || receiver == "" {
+		return nil, fmt.Errorf("--%s and --%s must be set together", FlagFunds, FlagReceiver)
+	}
+	coin, err := sdk.ParseCoinNormalized(fundsStr)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := sdk.AccAddressFromBech32(receiver); err != nil {
+		return nil, fmt.Errorf("invalid receiver: %w", err)
+	}
+	return &types.ReceivedFunds{Receiver: receiver, Coin: coin}, nil
+}
+
+// checkOnionTx runs keeper.CheckOnionTx against the state of the node and
+// records the checks in decoded, the checks after the failed one are
+// skipped. The packet carrying tx delivered funds, if set.
+func checkOnionTx(ctx context.Context, clientCtx client.Context, tx sdk.Tx, funds *types.ReceivedFunds, decoded *decodedMemo) error {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return err
+	}
+	nodeStatus, err := node.Status(ctx)
+	if err != nil {
+		return err
+	}
+	decoded.ChainID = nodeStatus.NodeInfo.Network
+	// the packet is received in the next block at the earliest, the checks
+	// run against a context of that block
+	blockTime := nodeStatus.SyncInfo.LatestBlockTime
+	if now := time.Now(); now.After(blockTime) {
+		blockTime = now
+	}
+	blockCtx := sdk.Context{}.
+		WithContext(ctx).
+		WithChainID(decoded.ChainID).
+		WithBlockHeight(nodeStatus.SyncInfo.LatestBlockHeight + 1).
+		WithBlockTime(blockTime)
+	if funds != nil {
+		blockCtx = types.WithReceivedFunds(blockCtx, *funds)
+	}
+
+	state := queryTxState{ctx: ctx, clientCtx: clientCtx}
+	failed, checkErr := keeper.CheckOnionTx(blockCtx, state, clientCtx.TxConfig.SignModeHandler(), tx, decoded.MemoDeadline, false)
+	result := "passed"
+	for _, check := range keeper.OnionTxChecks {
+		if check == failed {
+			decoded.Checks = append(decoded.Checks, onionTxCheck{Check: check, Result: "failed", Error: checkErr.Error()})
+			decoded.FailedCheck = failed
+			result = "skipped"
+			continue
+		}
+		decoded.Checks = append(decoded.Checks, onionTxCheck{Check: check, Result: result})
+	}
+
+	if decoded.Unordered {
+		return nil
+	}
+	for i, signer := range decoded.Signers {
+		addr, err := sdk.AccAddressFromBech32(signer.Address)
+		if err != nil {
+			return err
+		}
+		onionSeq, err := state.Sequence(addr)
+		if err != nil {
+			return err
+		}
+		decoded.Signers[i].OnionSequence = &onionSeq
+	}
+	return nil
+}
+
+// queryTxState is the keeper.OnionTxState of a node, read with queries.
+type queryTxState struct {
+	ctx       context.Context
+	clientCtx client.Context
+}
+
+func (s queryTxState) Params() (types.Params, error) {
+	res, err := types.NewQueryClient(s.clientCtx).Params(s.ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return types.Params{}, err
+	}
+	return res.Params, nil
+}
+
+func (s queryTxState) TxSigLimit() (uint64, error) {
+	res, err := authtypes.NewQueryClient(s.clientCtx).Params(s.ctx, &authtypes.QueryParamsRequest{})
+	if err != nil {
+		return 0, err
+	}
+	return res.Params.TxSigLimit, nil
+}
+
+func (s queryTxState) Account(addr sdk.AccAddress) (sdk.AccountI, error) {
+	res, err := authtypes.NewQueryClient(s.clientCtx).Account(s.ctx, &authtypes.QueryAccountRequest{Address: addr.String()})
+	if status.Code(err) == codes.NotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var acc sdk.AccountI
+	if err := s.clientCtx.InterfaceRegistry.UnpackAny(res.Account, &acc); err != nil {
+		return nil, err
+	}
+	return acc, nil
+}
+
+func (s queryTxState) Sequence(addr sdk.AccAddress) (uint64, error) {
+	res, err := types.NewQueryClient(s.clientCtx).Sequence(s.ctx, &types.QuerySequenceRequest{Address: addr.String()})
+	if err != nil {
+		return 0, err
+	}
+	return res.Seq.Sequence, nil
+}
+
+// UnorderedNonces reads the nonce window of addr from the module store, it
+// has no query of its own.
+func (s queryTxState) UnorderedNonces(addr sdk.AccAddress) (types.UnorderedNonces, error) {
+	key := append([]byte(types.OnionUnorderedNoncesPrefix), []byte(addr.String())...)
+	bz, _, err := s.clientCtx.QueryStore(key, types.StoreKey)
+	if err != nil {
+		return types.UnorderedNonces{}, err
+	}
+	if bz == nil {
+		return types.NewUnorderedNonces(addr.String()), nil
+	}
+	nonces := types.UnorderedNonces{}
+	if err := s.clientCtx.Codec.Unmarshal(bz, &nonces); err != nil {
+		return types.UnorderedNonces{}, err
+	}
+	return nonces, nil
+}
+
+// signModeString describes the sign modes of a signature, a multisig lists
+// the modes of its signatures.
+func signModeString(data signing.SignatureData) string {
+	switch data := data.(type) {
+	case *signing.SingleSignatureData:
+		return data.SignMode.String()
+	case *signing.MultiSignatureData:
+		modes := make([]string, len(data.Signatures))
+		for i, sig := range data.Signatures {
+			modes[i] = signModeString(sig)
+		}
+		return fmt.Sprintf("MULTISIG[%s]", strings.Join(modes, ","))
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,117 @@
+package cli_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"onion/x/onion/client/cli"
+	"onion/x/onion/types"
+)
+
+func TestDecodeMemoCmdOffline(t *testing.T) {
+	clientCtx := newClientCtx(t, "alice")
+	alice := keyAddress(t, clientCtx, "alice")
+	msgSend := banktypes.NewMsgSend(alice, sdk.AccAddress("recipient"), sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	unsignedTx := writeUnsignedTx(t, clientCtx, nil, msgSend)
+
+	deadline := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	specs := map[string]struct {
+		signArgs     []string
+		deadline     *time.Time
+		expFormat    string
+		expUnordered bool
+	}{
+		"json envelope": {
+			expFormat: "json",
+		},
+		"json envelope with deadline": {
+			deadline:  &deadline,
+			expFormat: "json",
+		},
+		"unordered": {
+			signArgs:     []string{"--" + cli.FlagUnordered},
+			expFormat:    "json",
+			expUnordered: true,
+		},
+		"legacy memo": {
+			signArgs:  []string{"--" + cli.FlagLegacyMemo},
+			expFormat: "legacy",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			signArgs := append([]string{
+				unsignedTx,
+				"--" + flags.FlagFrom + "=alice",
+				"--" + flags.FlagOffline,
+				"--" + flags.FlagChainID + "=" + testChainID,
+				"--" + flags.FlagSequence + "=6",
+			}, spec.signArgs...)
+			signed, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewSignTxCmd(), signArgs)
+			require.NoError(t, err)
+			memo := strings.TrimSpace(signed.String())
+			if spec.deadline != nil {
+				// the envelope deadline is set by the sender, outside the
+				// signed tx
+				parsed, _, err := types.ParseMemo(memo, false)
+				require.NoError(t, err)
+				memo, err = types.NewMemoWithDeadline(parsed.TxBytes, *spec.deadline)
+				require.NoError(t, err)
+			}
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewDecodeMemoCmd(), []string{memo, "--" + flags.FlagOffline})
+			require.NoError(t, err)
+
+			var decoded struct {
+				Format       string     `json:"format"`
+				MemoDeadline *time.Time `json:"memo_deadline"`
+				Signers      []struct {
+					Address       string  `json:"address"`
+					SignMode      string  `json:"sign_mode"`
+					Sequence      uint64  `json:"sequence"`
+					OnionSequence *uint64 `json:"onion_sequence"`
+				} `json:"signers"`
+				Unordered bool            `json:"unordered"`
+				Tx        json.RawMessage `json:"tx"`
+				ChainID   string          `json:"chain_id"`
+				Checks    []any           `json:"checks"`
+			}
+			require.NoError(t, json.Unmarshal(out.Bytes(), &decoded))
+			require.Equal(t, spec.expFormat, decoded.Format)
+			require.Equal(t, spec.deadline, decoded.MemoDeadline)
+			require.Equal(t, spec.expUnordered, decoded.Unordered)
+			require.Len(t, decoded.Signers, 1)
+			require.Equal(t, alice.String(), decoded.Signers[0].Address)
+			require.Equal(t, "SIGN_MODE_DIRECT", decoded.Signers[0].SignMode)
+			require.Equal(t, uint64(6), decoded.Signers[0].Sequence)
+
+			// nothing is checked against a node offline
+			require.Nil(t, decoded.Signers[0].OnionSequence)
+			require.Empty(t, decoded.ChainID)
+			require.Empty(t, decoded.Checks)
+
+			// the decoded tx is the signed one
+			tx, err := clientCtx.TxConfig.TxJSONDecoder()(decoded.Tx)
+			require.NoError(t, err)
+			require.Equal(t, []sdk.Msg{msgSend}, tx.GetMsgs())
+			signedTx, _ := decodeMemoTx(t, clientCtx, memo)
+			signedJSON, err := clientCtx.TxConfig.TxJSONEncoder()(signedTx)
+			require.NoError(t, err)
+			require.JSONEq(t, string(signedJSON), string(decoded.Tx))
+		})
+	}
+}
+
+func TestDecodeMemoCmdNotOnion(t *testing.T) {
+	clientCtx := newClientCtx(t)
+	_, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewDecodeMemoCmd(), []string{`{"wasm":{}}`, "--" + flags.FlagOffline})
+	require.ErrorContains(t, err, "memo does not carry an onion tx")
+}
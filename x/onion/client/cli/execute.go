@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"onion/x/onion/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+)
+
+// NewExecuteTxCmd returns a CLI command handler for creating a
+// MsgExecuteOnion transaction.
+func NewExecuteTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute [memo]",
+		Short: "Submit the onion tx of a memo directly, without an ICS-20 transfer",
+		Long: `Submit the onion tx carried by a memo, either JSON envelope or raw base64,
+with a MsgExecuteOnion signed by --from. The onion tx is checked like one
+carried by a packet, --from pays the gas and receives the onion tx fee. A memo
+deadline is not part of the signed tx and is ignored.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			parsed, found, err := types.ParseMemo(args[0], true)
+			if !found {
+				return errors.New("memo does not carry an onion tx")
+			}
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExecuteOnion(clientCtx.GetFromAddress().String(), parsed.TxBytes)
+			return sdktx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
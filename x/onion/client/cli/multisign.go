@@ -0,0 +1,365 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"onion/x/onion/types"
+
+	txsigning "cosmossdk.io/x/tx/signing"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+const (
+	// FlagMultisig makes sign-partial sign on behalf of a multisig address.
+	FlagMultisig = "multisig"
+	// FlagSignatureOnly makes multisign print the combined signature instead
+	// of the memo.
+	FlagSignatureOnly = "signature-only"
+)
+
+// NewSignPartialTxCmd returns a CLI command handler that signs an unsigned tx
+// as one of several onion signers.
+func NewSignPartialTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-partial [file]",
+		Short: "Sign an unsigned tx as one of its onion signers and print the signature",
+		Long: `Sign an unsigned tx, as produced by the --generate-only flag of any tx command,
+with the --from key and print the signature. Every signer of a tx with several
+signers signs the same file, the signatures are then put together by the
+assemble command. With --multisig the key signs on behalf of a multisig
+address, the signatures of its keys are combined by the multisign command.
+
+The signature covers the onion account number and the onion sequence of the
+signer, or of the multisig, which is queried from the node unless --offline
+or --sequence is set. The signature is made in amino json sign mode unless
+--sign-mode is set, the tx must then not have extension options such as the
+signed deadline of --deadline. Its timeout height can be used instead.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txBuilder, err := readTxBuilder(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress()
+			if multisigStr, _ := cmd.Flags().GetString(FlagMultisig); multisigStr != "" {
+				signer, err = multisigAddress(clientCtx, multisigStr)
+				if err != nil {
+					return err
+				}
+			}
+
+			txf, err := newOnionSignerFactory(clientCtx, cmd.Flags(), signer)
+			if err != nil {
+				return err
+			}
+			err = authclient.SignTxWithSignerAddress(txf, clientCtx, signer, clientCtx.GetFromName(), txBuilder, true, true)
+			if err != nil {
+				return err
+			}
+
+			sigs, err := txBuilder.GetTx().GetSignaturesV2()
+			if err != nil {
+				return err
+			}
+			json, err := clientCtx.TxConfig.MarshalSignatureJSON(sigs)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagMultisig, "", "Address or key name of the multisig to sign on behalf of")
+
+	return cmd
+}
+
+// NewMultisignTxCmd returns a CLI command handler that combines the
+// signatures of the keys of a multisig.
+func NewMultisignTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisign [file] [multisig-key] [signature-file]...",
+		Short: "Combine the signatures of the keys of a multisig and print the memo carrying the tx",
+		Long: `Combine the signatures made by the keys of a multisig with sign-partial --multisig
+into the signature of the multisig. [multisig-key] is the keyring name of the
+multisig, the signatures are verified with its onion sequence, which is
+queried from the node unless --offline or --sequence is set.
+
+When the multisig is the only signer of the tx, the memo carrying the signed tx
+is printed. Otherwise, or with --signature-only, the signature of the multisig
+is printed to be passed to assemble along with the other signatures.
+`,
+		Args: cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txBuilder, err := readTxBuilder(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			record, err := clientCtx.Keyring.Key(args[1])
+			if err != nil {
+				return err
+			}
+			pubKey, err := record.GetPubKey()
+			if err != nil {
+				return err
+			}
+			multisigPub, ok := pubKey.(*kmultisig.LegacyAminoPubKey)
+			if !ok {
+				return fmt.Errorf("%s is not a multisig key", args[1])
+			}
+			multisigAddr := sdk.AccAddress(multisigPub.Address())
+
+			txf, err := newOnionSignerFactory(clientCtx, cmd.Flags(), multisigAddr)
+			if err != nil {
+				return err
+			}
+
+			multisigSig := multisig.NewMultisig(len(multisigPub.PubKeys))
+			for _, sigFile := range args[2:] {
+				sigs, err := readSignatures(clientCtx, sigFile)
+				if err != nil {
+					return err
+				}
+				for _, sig := range sigs {
+					if sig.Sequence != txf.Sequence() {
+						return fmt.Errorf("signature of %s in %s is for onion sequence %d, expected %d", sdk.AccAddress(sig.PubKey.Address()), sigFile, sig.Sequence, txf.Sequence())
+					}
+					if err := verifyOnionSignature(cmd.Context(), clientCtx, txf.ChainID(), txBuilder.GetTx(), sig); err != nil {
+						return fmt.Errorf("invalid signature in %s: %w", sigFile, err)
+					}
+					if err := multisig.AddSignatureV2(multisigSig, sig, multisigPub.GetPubKeys()); err != nil {
+						return err
+					}
+				}
+			}
+			sig := signing.SignatureV2{
+				PubKey:   multisigPub,
+				Data:     multisigSig,
+				Sequence: txf.Sequence(),
+			}
+
+			signers, err := txBuilder.GetTx().GetSigners()
+			if err != nil {
+				return err
+			}
+			signatureOnly, _ := cmd.Flags().GetBool(FlagSignatureOnly)
+			if signatureOnly || len(signers) != 1 {
+				json, err := clientCtx.TxConfig.MarshalSignatureJSON([]signing.SignatureV2{sig})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+			}
+			if !multisigAddr.Equals(sdk.AccAddress(signers[0])) {
+				return fmt.Errorf("multisig %s is not the signer of the tx", multisigAddr)
+			}
+
+			if err := txBuilder.SetSignatures(sig); err != nil {
+				return err
+			}
+			return writeSignedOnionTx(clientCtx, cmd.Flags(), txBuilder)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Bool(FlagSignatureOnly, false, "Print the signature of the multisig instead of the memo")
+	cmd.Flags().Bool(FlagLegacyMemo, false, "Print the raw base64 encoded tx instead of the JSON memo envelope")
+
+	return cmd
+}
+
+// NewAssembleTxCmd returns a CLI command handler that puts the signatures of
+// all signers of a tx together.
+func NewAssembleTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "assemble [file] [signature-file]...",
+		Short: "Put the signatures of all onion signers of a tx together and print the memo carrying it",
+		Long: `Put the signatures made by sign-partial, or by multisign --signature-only, of all
+signers of an unsigned tx together and print the memo carrying the signed tx.
+The signatures are verified against --chain-id with the onion sequences they
+were made for.
+`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txBuilder, err := readTxBuilder(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			signers, err := txBuilder.GetTx().GetSigners()
+			if err != nil {
+				return err
+			}
+			signerSigs := make(map[string]*signing.SignatureV2, len(signers))
+			for _, signer := range signers {
+				signerSigs[sdk.AccAddress(signer).String()] = nil
+			}
+
+			for _, sigFile := range args[1:] {
+				sigs, err := readSignatures(clientCtx, sigFile)
+				if err != nil {
+					return err
+				}
+				for _, sig := range sigs {
+					signer := sdk.AccAddress(sig.PubKey.Address()).String()
+					prevSig, ok := signerSigs[signer]
+					if !ok {
+						return fmt.Errorf("%s in %s is not a signer of the tx", signer, sigFile)
+					}
+					if prevSig != nil {
+						return fmt.Errorf("duplicate signature of %s", signer)
+					}
+					if err := verifyOnionSignature(cmd.Context(), clientCtx, clientCtx.ChainID, txBuilder.GetTx(), sig); err != nil {
+						return fmt.Errorf("invalid signature in %s: %w", sigFile, err)
+					}
+					signerSigs[signer] = &sig
+				}
+			}
+
+			sigs := make([]signing.SignatureV2, len(signers))
+			for i, signer := range signers {
+				sig := signerSigs[sdk.AccAddress(signer).String()]
+				if sig == nil {
+					return fmt.Errorf("missing signature of %s", sdk.AccAddress(signer))
+				}
+				sigs[i] = *sig
+			}
+
+			if err := txBuilder.SetSignatures(sigs...); err != nil {
+				return err
+			}
+			return writeSignedOnionTx(clientCtx, cmd.Flags(), txBuilder)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Bool(FlagLegacyMemo, false, "Print the raw base64 encoded tx instead of the JSON memo envelope")
+
+	return cmd
+}
+
+// readTxBuilder reads the unsigned tx in filename into a tx builder.
+func readTxBuilder(clientCtx client.Context, filename string) (client.TxBuilder, error) {
+	tx, err := authclient.ReadTxFromFile(clientCtx, filename)
+	if err != nil {
+		return nil, err
+	}
+	if len(tx.GetMsgs()) == 0 {
+		return nil, errors.New("tx has no messages")
+	}
+	return clientCtx.TxConfig.WrapTxBuilder(tx)
+}
+
+// readSignatures reads the signatures printed by sign-partial or multisign.
+func readSignatures(clientCtx client.Context, filename string) ([]signing.SignatureV2, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return clientCtx.TxConfig.UnmarshalSignatureJSON(bz)
+}
+
+// multisigAddress returns the address of a multisig given by key name or
+// address.
+func multisigAddress(clientCtx client.Context, multisigStr string) (sdk.AccAddress, error) {
+	if record, err := clientCtx.Keyring.Key(multisigStr); err == nil {
+		return record.GetAddress()
+	}
+	return sdk.AccAddressFromBech32(multisigStr)
+}
+
+// newOnionSignerFactory returns a tx factory signing for signer with the
+// onion account number and its onion sequence, --sequence in offline mode or
+// when set.
+func newOnionSignerFactory(clientCtx client.Context, flagSet *pflag.FlagSet, signer sdk.AccAddress) (sdktx.Factory, error) {
+	txf, err := newOnionFactoryCLI(clientCtx, flagSet)
+	if err != nil {
+		return txf, err
+	}
+	if clientCtx.ChainID == "" {
+		return txf, errors.New("--chain-id is required")
+	}
+
+	if !clientCtx.Offline && !flagSet.Changed(flags.FlagSequence) {
+		res, err := types.NewQueryClient(clientCtx).Sequence(context.Background(), &types.QuerySequenceRequest{
+			Address: signer.String(),
+		})
+		if err != nil {
+			return txf, err
+		}
+		txf = txf.WithSequence(res.Seq.Sequence)
+	}
+	return txf.WithAccountNumber(types.AccountNumber), nil
+}
+
+// verifyOnionSignature verifies sig against tx as ExecuteAnte does, with the
+// onion account number and the sequence of sig.
+func verifyOnionSignature(ctx context.Context, clientCtx client.Context, chainID string, tx sdk.Tx, sig signing.SignatureV2) error {
+	adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
+	}
+	anyPk, err := codectypes.NewAnyWithValue(sig.PubKey)
+	if err != nil {
+		return err
+	}
+	signerData := txsigning.SignerData{
+		Address:       sdk.AccAddress(sig.PubKey.Address()).String(),
+		ChainID:       chainID,
+		AccountNumber: types.AccountNumber,
+		Sequence:      sig.Sequence,
+		PubKey: &anypb.Any{
+			TypeUrl: anyPk.TypeUrl,
+			Value:   anyPk.Value,
+		},
+	}
+	return authsigning.VerifySignature(ctx, sig.PubKey, signerData, sig.Data, clientCtx.TxConfig.SignModeHandler(), adaptableTx.GetSigningTxData())
+}
+
+// writeSignedOnionTx prints the memo carrying the signed tx of txBuilder.
+func writeSignedOnionTx(clientCtx client.Context, flagSet *pflag.FlagSet, txBuilder client.TxBuilder) error {
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+	memo, err := newOnionMemo(flagSet, txBytes)
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintString(fmt.Sprintf("%s\n", memo))
+}
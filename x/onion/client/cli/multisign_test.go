@@ -0,0 +1,177 @@
+package cli_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"onion/x/onion/client/cli"
+)
+
+// newMultisigClientCtx returns the client context of newClientCtx with a
+// 2-of-2 multisig key "multi" of alice and bob.
+func newMultisigClientCtx(t *testing.T) (client.Context, sdk.AccAddress) {
+	clientCtx := newClientCtx(t, "alice", "bob", "carol")
+	var pubKeys []cryptotypes.PubKey
+	for _, name := range []string{"alice", "bob"} {
+		record, err := clientCtx.Keyring.Key(name)
+		require.NoError(t, err)
+		pubKey, err := record.GetPubKey()
+		require.NoError(t, err)
+		pubKeys = append(pubKeys, pubKey)
+	}
+	multisigPub := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+	_, err := clientCtx.Keyring.SaveMultisig("multi", multisigPub)
+	require.NoError(t, err)
+	return clientCtx, sdk.AccAddress(multisigPub.Address())
+}
+
+// execToFile runs cmd offline for the test chain and writes its output to a
+// file whose path is returned.
+func execToFile(t *testing.T, clientCtx client.Context, cmd *cobra.Command, args ...string) string {
+	args = append(args, "--"+flags.FlagOffline, "--"+flags.FlagChainID+"="+testChainID)
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, args)
+	require.NoError(t, err)
+	filename := filepath.Join(t.TempDir(), "out.json")
+	require.NoError(t, os.WriteFile(filename, out.Bytes(), 0o600))
+	return filename
+}
+
+func TestMultisignTxCmd(t *testing.T) {
+	clientCtx, multi := newMultisigClientCtx(t)
+	msgSend := banktypes.NewMsgSend(multi, sdk.AccAddress("recipient"), sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	unsignedTx := writeUnsignedTx(t, clientCtx, nil, msgSend)
+
+	aliceSig := execToFile(t, clientCtx, cli.NewSignPartialTxCmd(), unsignedTx,
+		"--"+flags.FlagFrom+"=alice", "--"+cli.FlagMultisig+"=multi", "--"+flags.FlagSequence+"=7")
+	bobSig := execToFile(t, clientCtx, cli.NewSignPartialTxCmd(), unsignedTx,
+		"--"+flags.FlagFrom+"=bob", "--"+cli.FlagMultisig+"="+multi.String(), "--"+flags.FlagSequence+"=7")
+
+	otherTx := writeUnsignedTx(t, clientCtx, func(b client.TxBuilder) { b.SetMemo("other") }, msgSend)
+	otherSig := execToFile(t, clientCtx, cli.NewSignPartialTxCmd(), otherTx,
+		"--"+flags.FlagFrom+"=alice", "--"+cli.FlagMultisig+"=multi", "--"+flags.FlagSequence+"=7")
+
+	t.Run("memo of the single multisig signer", func(t *testing.T) {
+		out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewMultisignTxCmd(), []string{
+			unsignedTx, "multi", aliceSig, bobSig,
+			"--" + flags.FlagOffline, "--" + flags.FlagChainID + "=" + testChainID, "--" + flags.FlagSequence + "=7",
+		})
+		require.NoError(t, err)
+
+		tx, envelope := decodeMemoTx(t, clientCtx, out.String())
+		require.True(t, envelope)
+		require.Equal(t, []sdk.Msg{msgSend}, tx.GetMsgs())
+		requireOnionSignatures(t, clientCtx, tx, testChainID, []sdk.AccAddress{multi}, []uint64{7})
+		sigs, err := tx.GetSignaturesV2()
+		require.NoError(t, err)
+		multiSigData, ok := sigs[0].Data.(*signing.MultiSignatureData)
+		require.True(t, ok)
+		require.Len(t, multiSigData.Signatures, 2)
+	})
+
+	t.Run("signature only", func(t *testing.T) {
+		sigFile := execToFile(t, clientCtx, cli.NewMultisignTxCmd(), unsignedTx, "multi", aliceSig, bobSig,
+			"--"+flags.FlagSequence+"=7", "--"+cli.FlagSignatureOnly)
+		bz, err := os.ReadFile(sigFile)
+		require.NoError(t, err)
+		sigs, err := clientCtx.TxConfig.UnmarshalSignatureJSON(bz)
+		require.NoError(t, err)
+		require.Len(t, sigs, 1)
+		require.Equal(t, multi, sdk.AccAddress(sigs[0].PubKey.Address()))
+	})
+
+	specs := map[string]struct {
+		args   []string
+		expErr string
+	}{
+		"other onion sequence": {
+			args:   []string{unsignedTx, "multi", aliceSig, bobSig, "--" + flags.FlagSequence + "=8"},
+			expErr: "is for onion sequence 7, expected 8",
+		},
+		"not a multisig key": {
+			args:   []string{unsignedTx, "alice", aliceSig, "--" + flags.FlagSequence + "=7"},
+			expErr: "alice is not a multisig key",
+		},
+		"signature of another tx": {
+			args:   []string{unsignedTx, "multi", otherSig, bobSig, "--" + flags.FlagSequence + "=7"},
+			expErr: "invalid signature in " + otherSig,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			args := append(spec.args, "--"+flags.FlagOffline, "--"+flags.FlagChainID+"="+testChainID)
+			_, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewMultisignTxCmd(), args)
+			require.ErrorContains(t, err, spec.expErr)
+		})
+	}
+}
+
+func TestAssembleTxCmd(t *testing.T) {
+	clientCtx, multi := newMultisigClientCtx(t)
+	carol := keyAddress(t, clientCtx, "carol")
+	unsignedTx := writeUnsignedTx(t, clientCtx, nil,
+		banktypes.NewMsgSend(carol, sdk.AccAddress("recipient"), sdk.NewCoins(sdk.NewInt64Coin("stake", 100))),
+		banktypes.NewMsgSend(multi, sdk.AccAddress("recipient"), sdk.NewCoins(sdk.NewInt64Coin("stake", 200))),
+	)
+
+	carolSig := execToFile(t, clientCtx, cli.NewSignPartialTxCmd(), unsignedTx,
+		"--"+flags.FlagFrom+"=carol", "--"+flags.FlagSequence+"=3")
+	aliceSig := execToFile(t, clientCtx, cli.NewSignPartialTxCmd(), unsignedTx,
+		"--"+flags.FlagFrom+"=alice", "--"+cli.FlagMultisig+"=multi", "--"+flags.FlagSequence+"=7")
+	bobSig := execToFile(t, clientCtx, cli.NewSignPartialTxCmd(), unsignedTx,
+		"--"+flags.FlagFrom+"=bob", "--"+cli.FlagMultisig+"=multi", "--"+flags.FlagSequence+"=7")
+	// with several signers multisign prints the signature of the multisig
+	multiSig := execToFile(t, clientCtx, cli.NewMultisignTxCmd(), unsignedTx, "multi", aliceSig, bobSig,
+		"--"+flags.FlagSequence+"=7")
+
+	specs := map[string]struct {
+		sigFiles []string
+		expErr   string
+	}{
+		"signatures in signer order": {
+			sigFiles: []string{carolSig, multiSig},
+		},
+		"signatures in any order": {
+			sigFiles: []string{multiSig, carolSig},
+		},
+		"missing signature": {
+			sigFiles: []string{carolSig},
+			expErr:   "missing signature of " + multi.String(),
+		},
+		"duplicate signature": {
+			sigFiles: []string{carolSig, carolSig, multiSig},
+			expErr:   "duplicate signature of " + carol.String(),
+		},
+		"not a signer": {
+			sigFiles: []string{carolSig, aliceSig},
+			expErr:   "is not a signer of the tx",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			args := append([]string{unsignedTx}, spec.sigFiles...)
+			args = append(args, "--"+flags.FlagOffline, "--"+flags.FlagChainID+"="+testChainID)
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewAssembleTxCmd(), args)
+			if spec.expErr != "" {
+				require.ErrorContains(t, err, spec.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			tx, _ := decodeMemoTx(t, clientCtx, out.String())
+			require.Len(t, tx.GetMsgs(), 2)
+			requireOnionSignatures(t, clientCtx, tx, testChainID, []sdk.AccAddress{carol, multi}, []uint64{3, 7})
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"onion/x/onion/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+)
+
+// FlagOnion makes a tx command print the memo carrying its messages as onion
+// tx instead of broadcasting them.
+const FlagOnion = "onion"
+
+// AddOnionFlag adds --onion to the tx commands below txCmd. Module commands,
+// whether autocli generated or custom, sign their messages as onion tx with
+// it. Of the commands directly below txCmd only sign supports it, it then
+// behaves like the sign command of the onion module. The commands of the
+// onion module print memos anyway and are left as they are.
+func AddOnionFlag(txCmd *cobra.Command) {
+	for _, cmd := range txCmd.Commands() {
+		switch {
+		case cmd.Name() == types.ModuleName:
+		case cmd.HasSubCommands():
+			addOnionFlagToModule(cmd)
+		case cmd.Name() == "sign":
+			wrapRunE(cmd, func(cmd *cobra.Command, args []string) error {
+				return signUnsignedTxFile(cmd, args[0])
+			})
+		}
+	}
+}
+
+func addOnionFlagToModule(cmd *cobra.Command) {
+	for _, subCmd := range cmd.Commands() {
+		if subCmd.HasSubCommands() {
+			addOnionFlagToModule(subCmd)
+			continue
+		}
+		// only commands building txs from messages can generate them
+		if subCmd.RunE == nil || subCmd.Flags().Lookup(flags.FlagGenerateOnly) == nil {
+			continue
+		}
+		runE := subCmd.RunE
+		wrapRunE(subCmd, func(cmd *cobra.Command, args []string) error {
+			return runAsOnionTx(cmd, args, runE)
+		})
+	}
+}
+
+// wrapRunE adds the onion flags to cmd and runs onionRunE instead of its
+// RunE when --onion is set.
+func wrapRunE(cmd *cobra.Command, onionRunE func(*cobra.Command, []string) error) {
+	runE := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if onion, _ := cmd.Flags().GetBool(FlagOnion); onion {
+			return onionRunE(cmd, args)
+		}
+		return runE(cmd, args)
+	}
+	cmd.Flags().Bool(FlagOnion, false, "Print the memo carrying the messages as onion tx instead of broadcasting them")
+	addOnionTxFlags(cmd)
+}
+
+// runAsOnionTx runs a tx command in offline generate-only mode to capture the
+// messages it builds, then signs them as onion tx through WriteBase64Tx.
+func runAsOnionTx(cmd *cobra.Command, args []string, runE func(*cobra.Command, []string) error) error {
+	overrides := map[string]string{
+		flags.FlagGenerateOnly:  "true",
+		flags.FlagOffline:       "true",
+		flags.FlagChainID:       "",
+		flags.FlagAccountNumber: "0",
+		flags.FlagSequence:      "0",
+	}
+	// the gas of the onion tx is estimated by WriteBase64Tx
+	if gas, _ := cmd.Flags().GetString(flags.FlagGas); gas == flags.GasFlagAuto {
+		overrides[flags.FlagGas] = strconv.Itoa(flags.DefaultGasLimit)
+	}
+	restore, err := overrideFlags(cmd.Flags(), overrides)
+	if err != nil {
+		return err
+	}
+
+	var unsignedTxJSON bytes.Buffer
+	out, cmdCtx := cmd.OutOrStdout(), cmd.Context()
+	clientCtx := client.GetClientContextFromCmd(cmd)
+	captureCtx := clientCtx.WithChainID("").WithOutput(&unsignedTxJSON)
+	cmd.SetOut(&unsignedTxJSON)
+	cmd.SetContext(context.WithValue(cmdCtx, client.ClientContextKey, &captureCtx))
+
+	err = runE(cmd, args)
+
+	// autocli commands replace the command context with the client context
+	// of the autocli builder, which is the one to sign with then
+	if v, ok := cmd.Context().Value(client.ClientContextKey).(*client.Context); ok && v != &captureCtx {
+		clientCtx = *v
+	}
+	cmd.SetOut(out)
+	cmd.SetContext(context.WithValue(cmdCtx, client.ClientContextKey, &clientCtx))
+	restore()
+	if err != nil {
+		return err
+	}
+
+	clientCtx, err = client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+	// the client context of autocli has no output, it prints to the output
+	// of the command like autocli does
+	clientCtx = clientCtx.WithOutput(out)
+	unsignedTx, err := clientCtx.TxConfig.TxJSONDecoder()(unsignedTxJSON.Bytes())
+	if err != nil {
+		return err
+	}
+	if len(unsignedTx.GetMsgs()) == 0 {
+		return errors.New("command did not generate any messages")
+	}
+
+	return WriteBase64Tx(clientCtx, cmd.Flags(), unsignedTx.GetMsgs()...)
+}
+
+// overrideFlags sets flags to the given values and returns a function that
+// restores their previous values.
+func overrideFlags(flagSet *pflag.FlagSet, values map[string]string) (func(), error) {
+	var restores []func()
+	restore := func() {
+		for _, r := range restores {
+			r()
+		}
+	}
+	for name, value := range values {
+		flag := flagSet.Lookup(name)
+		if flag == nil {
+			continue
+		}
+		prevValue, prevChanged := flag.Value.String(), flag.Changed
+		if err := flagSet.Set(name, value); err != nil {
+			restore()
+			return nil, err
+		}
+		restores = append(restores, func() {
+			_ = flag.Value.Set(prevValue)
+			flag.Changed = prevChanged
+		})
+	}
+	return restore, nil
+}
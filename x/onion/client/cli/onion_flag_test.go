@@ -0,0 +1,160 @@
+package cli_test
+
+import (
+	"testing"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	"cosmossdk.io/client/v2/autocli"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	bankcli "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"onion/x/onion/client/cli"
+	"onion/x/onion/types"
+)
+
+// newBankTxCmd returns a tx root command with the bank send command below it
+// and --onion added by AddOnionFlag.
+func newBankTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{Use: "tx"}
+	bankCmd := &cobra.Command{Use: banktypes.ModuleName}
+	bankCmd.AddCommand(bankcli.NewSendTxCmd(addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())))
+	txCmd.AddCommand(bankCmd)
+	cli.AddOnionFlag(txCmd)
+	return txCmd
+}
+
+// newAutoCLIRootCmd returns a root command with the tx commands autocli
+// generates for bank from clientCtx, with --onion added by AddOnionFlag.
+func newAutoCLIRootCmd(t *testing.T, clientCtx client.Context) *cobra.Command {
+	cfg := sdk.GetConfig()
+	rootCmd := &cobra.Command{Use: "root"}
+	appOptions := autocli.AppOptions{
+		ModuleOptions: map[string]*autocliv1.ModuleOptions{
+			banktypes.ModuleName: {Tx: bank.AppModule{}.AutoCLIOptions().Tx},
+		},
+		AddressCodec:          addresscodec.NewBech32Codec(cfg.GetBech32AccountAddrPrefix()),
+		ValidatorAddressCodec: addresscodec.NewBech32Codec(cfg.GetBech32ValidatorAddrPrefix()),
+		ConsensusAddressCodec: addresscodec.NewBech32Codec(cfg.GetBech32ConsensusAddrPrefix()),
+		ClientCtx:             clientCtx,
+	}
+	require.NoError(t, appOptions.EnhanceRootCommand(rootCmd))
+
+	txCmd, _, err := rootCmd.Find([]string{"tx"})
+	require.NoError(t, err)
+	cli.AddOnionFlag(txCmd)
+	return rootCmd
+}
+
+func TestOnionFlag(t *testing.T) {
+	clientCtx := newClientCtx(t, "alice")
+	alice := keyAddress(t, clientCtx, "alice")
+	recipient := sdk.AccAddress("recipient")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	// the flags are overridden while the messages are captured and must be
+	// restored for signing the onion tx
+	args := []string{
+		banktypes.ModuleName, "send", "alice", recipient.String(), amount.String(),
+		"--" + cli.FlagOnion,
+		"--" + flags.FlagOffline,
+		"--" + flags.FlagChainID + "=" + testChainID,
+		"--" + flags.FlagSequence + "=5",
+		"--" + flags.FlagGas + "=150000",
+		"--" + flags.FlagFees + "=7stake",
+		"--" + flags.FlagNote + "=note",
+	}
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, newBankTxCmd(), args)
+	require.NoError(t, err)
+
+	tx, envelope := decodeMemoTx(t, clientCtx, out.String())
+	require.True(t, envelope)
+	require.Equal(t, []sdk.Msg{banktypes.NewMsgSend(alice, recipient, amount)}, tx.GetMsgs())
+	require.Equal(t, uint64(150_000), tx.GetGas())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 7)), tx.GetFee())
+	require.Equal(t, "note", tx.GetMemo())
+	requireOnionSignature(t, clientCtx, tx, testChainID, "alice", 5)
+}
+
+func TestOnionFlagNotSet(t *testing.T) {
+	clientCtx := newClientCtx(t, "alice")
+	alice := keyAddress(t, clientCtx, "alice")
+	recipient := sdk.AccAddress("recipient")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	args := []string{
+		banktypes.ModuleName, "send", "alice", recipient.String(), amount.String(),
+		"--" + flags.FlagGenerateOnly,
+		"--" + flags.FlagChainID + "=" + testChainID,
+	}
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, newBankTxCmd(), args)
+	require.NoError(t, err)
+
+	// the command prints the unsigned tx as it does without AddOnionFlag
+	tx, err := clientCtx.TxConfig.TxJSONDecoder()(out.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{banktypes.NewMsgSend(alice, recipient, amount)}, tx.GetMsgs())
+}
+
+func TestOnionFlagAutoCLI(t *testing.T) {
+	clientCtx := newClientCtx(t, "alice")
+	alice := keyAddress(t, clientCtx, "alice")
+	recipient := sdk.AccAddress("recipient")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	args := []string{
+		"tx", banktypes.ModuleName, "send", alice.String(), recipient.String(), amount.String(),
+		"--" + cli.FlagOnion,
+		"--" + flags.FlagFrom + "=alice",
+		"--" + flags.FlagOffline,
+		"--" + flags.FlagChainID + "=" + testChainID,
+		"--" + flags.FlagSequence + "=2",
+		"--" + flags.FlagFees + "=7stake",
+	}
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, newAutoCLIRootCmd(t, clientCtx), args)
+	require.NoError(t, err)
+
+	tx, envelope := decodeMemoTx(t, clientCtx, out.String())
+	require.True(t, envelope)
+	require.Equal(t, []sdk.Msg{banktypes.NewMsgSend(alice, recipient, amount)}, tx.GetMsgs())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 7)), tx.GetFee())
+	requireOnionSignature(t, clientCtx, tx, testChainID, "alice", 2)
+}
+
+func TestOnionFlagQueriesSequence(t *testing.T) {
+	clientCtx := newClientCtx(t, "alice")
+	alice := keyAddress(t, clientCtx, "alice")
+	recipient := sdk.AccAddress("recipient")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	// the node answers the onion sequence query, the account sequence is
+	// not the one to sign with
+	res, err := clientCtx.Codec.Marshal(&types.QuerySequenceResponse{
+		Seq: types.OnionSequence{Address: alice.String(), Sequence: 7},
+	})
+	require.NoError(t, err)
+	clientCtx = clientCtx.
+		WithClient(clitestutil.NewMockCometRPC(abci.ResponseQuery{Value: res})).
+		WithAccountRetriever(client.TestAccountRetriever{Accounts: map[string]client.TestAccount{
+			alice.String(): {Address: alice, Num: 3, Seq: 9},
+		}})
+
+	args := []string{
+		banktypes.ModuleName, "send", "alice", recipient.String(), amount.String(),
+		"--" + cli.FlagOnion,
+		"--" + flags.FlagChainID + "=" + testChainID,
+	}
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, newBankTxCmd(), args)
+	require.NoError(t, err)
+
+	tx, _ := decodeMemoTx(t, clientCtx, out.String())
+	requireOnionSignature(t, clientCtx, tx, testChainID, "alice", 7)
+}
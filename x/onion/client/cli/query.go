@@ -0,0 +1,27 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"onion/x/onion/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// NewQueryCmd returns the root CLI command handler of the onion queries that
+// are not generated by autocli.
+func NewQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the onion module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		NewDecodeMemoCmd(),
+	)
+
+	return queryCmd
+}
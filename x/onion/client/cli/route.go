@@ -0,0 +1,302 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"onion/x/onion/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+)
+
+// RouteHop is one chain of a multi-hop onion route. Every hop but the last
+// one transfers Token over Port and Channel to the signer of the next hop,
+// with the onion memo of the next hop.
+type RouteHop struct {
+	// ChainID is the chain the onion tx of the hop is executed on.
+	ChainID string
+	// Key names the keyring key signing the onion tx of the hop. The
+	// transfer of the previous hop is sent to its address.
+	Key string
+	// Bech32Prefix is the account prefix of the chain, it defaults to the
+	// prefix of this chain.
+	Bech32Prefix string
+	// Sequence is the onion sequence of Key on the chain.
+	Sequence uint64
+	// Msgs are executed before the transfer to the next hop.
+	Msgs     []sdk.Msg
+	GasLimit uint64
+	Fees     sdk.Coins
+
+	// the transfer to the next hop, Port defaults to the transfer port
+	Port             string
+	Channel          string
+	Token            sdk.Coin
+	TimeoutHeight    clienttypes.Height
+	TimeoutTimestamp uint64
+}
+
+// BuildRouteMemo signs the onion txs of a multi-hop route, innermost first,
+// and returns the memo for the first hop. The tx of each hop but the last
+// ends with a MsgTransfer to the next hop whose memo is the layer of the next
+// hop.
+func BuildRouteMemo(ctx context.Context, txConfig client.TxConfig, kr keyring.Keyring, hops []RouteHop) (string, error) {
+	if len(hops) == 0 {
+		return "", errors.New("route has no hops")
+	}
+
+	var memo string
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := hops[i]
+		msgs := append([]sdk.Msg{}, hop.Msgs...)
+		if i < len(hops)-1 {
+			msg, err := newRouteTransfer(kr, hop, hops[i+1], memo)
+			if err != nil {
+				return "", fmt.Errorf("hop %d: %w", i, err)
+			}
+			msgs = append(msgs, msg)
+		}
+		if len(msgs) == 0 {
+			return "", fmt.Errorf("hop %d: no messages", i)
+		}
+
+		txBytes, err := signRouteHop(ctx, txConfig, kr, hop, msgs)
+		if err != nil {
+			return "", fmt.Errorf("hop %d: %w", i, err)
+		}
+		memo, err = types.NewMemo(txBytes)
+		if err != nil {
+			return "", err
+		}
+	}
+	return memo, nil
+}
+
+// newRouteTransfer returns the MsgTransfer of hop carrying memo to the signer
+// of next.
+func newRouteTransfer(kr keyring.Keyring, hop, next RouteHop, memo string) (*transfertypes.MsgTransfer, error) {
+	if hop.Channel == "" {
+		return nil, errors.New("channel to the next hop is required")
+	}
+	if !hop.Token.IsValid() || !hop.Token.IsPositive() {
+		return nil, fmt.Errorf("invalid token %s for the next hop", hop.Token)
+	}
+	if hop.TimeoutHeight.IsZero() && hop.TimeoutTimestamp == 0 {
+		return nil, errors.New("either a packet timeout height or timestamp is required")
+	}
+
+	sender, err := routeHopAddress(kr, hop)
+	if err != nil {
+		return nil, err
+	}
+	receiver, err := routeHopAddress(kr, next)
+	if err != nil {
+		return nil, err
+	}
+
+	port := hop.Port
+	if port == "" {
+		port = transfertypes.PortID
+	}
+	// the addresses have the prefixes of their chains, the msg is validated
+	// there
+	return transfertypes.NewMsgTransfer(port, hop.Channel, hop.Token, sender, receiver, hop.TimeoutHeight, hop.TimeoutTimestamp, memo), nil
+}
+
+// routeHopAddress returns the address of the key of hop on its chain.
+func routeHopAddress(kr keyring.Keyring, hop RouteHop) (string, error) {
+	record, err := kr.Key(hop.Key)
+	if err != nil {
+		return "", err
+	}
+	addr, err := record.GetAddress()
+	if err != nil {
+		return "", err
+	}
+	prefix := hop.Bech32Prefix
+	if prefix == "" {
+		prefix = sdk.GetConfig().GetBech32AccountAddrPrefix()
+	}
+	return sdk.Bech32ifyAddressBytes(prefix, addr)
+}
+
+// signRouteHop builds msgs into a tx signed by the key of hop with its onion
+// sequence and encodes it.
+func signRouteHop(ctx context.Context, txConfig client.TxConfig, kr keyring.Keyring, hop RouteHop, msgs []sdk.Msg) ([]byte, error) {
+	txBuilder := txConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(msgs...); err != nil {
+		return nil, err
+	}
+	gas := hop.GasLimit
+	if gas == 0 {
+		gas = flags.DefaultGasLimit
+	}
+	txBuilder.SetGasLimit(gas)
+	txBuilder.SetFeeAmount(hop.Fees)
+
+	txf := sdktx.Factory{}.
+		WithTxConfig(txConfig).
+		WithKeybase(kr).
+		WithChainID(hop.ChainID).
+		WithAccountNumber(types.AccountNumber).
+		WithSequence(hop.Sequence)
+	if err := sdktx.Sign(ctx, txf, hop.Key, txBuilder, true); err != nil {
+		return nil, err
+	}
+
+	return txConfig.TxEncoder()(txBuilder.GetTx())
+}
+
+// routeFile is the JSON format of the route read by the route command.
+type routeFile struct {
+	Hops []struct {
+		ChainID       string            `json:"chain_id"`
+		Key           string            `json:"key"`
+		Prefix        string            `json:"prefix"`
+		Sequence      uint64            `json:"sequence"`
+		Msgs          []json.RawMessage `json:"msgs"`
+		Gas           uint64            `json:"gas"`
+		Fees          string            `json:"fees"`
+		Port          string            `json:"port"`
+		Channel       string            `json:"channel"`
+		Token         string            `json:"token"`
+		TimeoutHeight string            `json:"timeout_height"`
+	} `json:"hops"`
+}
+
+// NewRouteTxCmd returns a CLI command handler that builds the nested onion
+// memos of a multi-hop route.
+func NewRouteTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "route [route-file]",
+		Short: "Sign the onion txs of a multi-hop route and print the memo for the first hop",
+		Long: `Sign an onion tx for every hop of a route and print the memo carrying the tx of
+the first hop. The tx of each hop but the last ends with a MsgTransfer of
+"token" over "port" and "channel" to the key of the next hop, whose memo is the
+onion tx of the next hop. The first memo is to be sent to the key of the first
+hop, e.g. with ibc-transfer.
+
+Each hop is signed by its keyring key with its onion sequence, no node is
+contacted. The messages are JSON encoded with their "@type" and must be known
+to this binary. Example route file:
+
+{
+  "hops": [
+    {
+      "chain_id": "chain-b",
+      "key": "alice",
+      "prefix": "b",
+      "sequence": 0,
+      "gas": 400000,
+      "msgs": [],
+      "channel": "channel-2",
+      "token": "100ibc/...",
+      "timeout_height": "0-0"
+    },
+    {
+      "chain_id": "chain-c",
+      "key": "bob",
+      "prefix": "c",
+      "sequence": 3,
+      "msgs": [{"@type": "/cosmos.bank.v1beta1.MsgSend", ...}]
+    }
+  ]
+}
+
+The packet timeout timestamp of every transfer is --packet-timeout-timestamp.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			timeoutTimestamp, _ := cmd.Flags().GetUint64(FlagPacketTimeoutTimestamp)
+			if timeoutTimestamp != 0 {
+				timeoutTimestamp += uint64(time.Now().UnixNano())
+			}
+			hops, err := readRouteFile(clientCtx, args[0], timeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			memo, err := BuildRouteMemo(cmd.Context(), clientCtx.TxConfig, clientCtx.Keyring, hops)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", memo))
+		},
+	}
+
+	flags.AddKeyringFlags(cmd.Flags())
+	cmd.Flags().Uint64(FlagPacketTimeoutTimestamp, transfertypes.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp of the transfers in nanoseconds relative to the local clock, 0 disables it")
+
+	return cmd
+}
+
+// readRouteFile reads the hops of a route file, see NewRouteTxCmd.
+func readRouteFile(clientCtx client.Context, filename string, timeoutTimestamp uint64) ([]RouteHop, error) {
+	bz, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	var route routeFile
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&route); err != nil {
+		return nil, fmt.Errorf("invalid route file: %w", err)
+	}
+
+	hops := make([]RouteHop, len(route.Hops))
+	for i, h := range route.Hops {
+		hop := RouteHop{
+			ChainID:          h.ChainID,
+			Key:              h.Key,
+			Bech32Prefix:     h.Prefix,
+			Sequence:         h.Sequence,
+			GasLimit:         h.Gas,
+			Port:             h.Port,
+			Channel:          h.Channel,
+			TimeoutTimestamp: timeoutTimestamp,
+		}
+		if hop.ChainID == "" || hop.Key == "" {
+			return nil, fmt.Errorf("hop %d: chain_id and key are required", i)
+		}
+		for _, rawMsg := range h.Msgs {
+			var msg sdk.Msg
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON(rawMsg, &msg); err != nil {
+				return nil, fmt.Errorf("hop %d: %w", i, err)
+			}
+			hop.Msgs = append(hop.Msgs, msg)
+		}
+		if hop.Fees, err = sdk.ParseCoinsNormalized(h.Fees); err != nil {
+			return nil, fmt.Errorf("hop %d: %w", i, err)
+		}
+		if h.Token != "" {
+			if hop.Token, err = sdk.ParseCoinNormalized(h.Token); err != nil {
+				return nil, fmt.Errorf("hop %d: %w", i, err)
+			}
+		}
+		if h.TimeoutHeight != "" {
+			if hop.TimeoutHeight, err = clienttypes.ParseHeight(h.TimeoutHeight); err != nil {
+				return nil, fmt.Errorf("hop %d: %w", i, err)
+			}
+		}
+		hops[i] = hop
+	}
+	return hops, nil
+}
@@ -0,0 +1,130 @@
+package cli_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+
+	"onion/x/onion/client/cli"
+)
+
+func TestBuildRouteMemo(t *testing.T) {
+	clientCtx := newClientCtx(t, "alice", "bob", "carol")
+	alice, bob, carol := keyAddress(t, clientCtx, "alice"), keyAddress(t, clientCtx, "bob"), keyAddress(t, clientCtx, "carol")
+	msgSend := banktypes.NewMsgSend(carol, sdk.AccAddress("recipient"), sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+	newHops := func() []cli.RouteHop {
+		return []cli.RouteHop{
+			{
+				ChainID: "chain-b", Key: "alice", Bech32Prefix: "b", Sequence: 1,
+				Channel: "channel-1", Token: sdk.NewInt64Coin("uatom", 30),
+				TimeoutHeight: clienttypes.NewHeight(1, 100),
+			},
+			{
+				ChainID: "chain-c", Key: "bob", Bech32Prefix: "c", Sequence: 2,
+				Msgs: []sdk.Msg{banktypes.NewMsgSend(bob, sdk.AccAddress("fee"), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))},
+				Port: "custom", Channel: "channel-2", Token: sdk.NewInt64Coin("uatom", 20),
+				TimeoutTimestamp: 1_000,
+			},
+			{
+				ChainID: "chain-d", Key: "carol", Bech32Prefix: "d", Sequence: 3,
+				Msgs: []sdk.Msg{msgSend},
+			},
+		}
+	}
+
+	t.Run("peels hop by hop", func(t *testing.T) {
+		memo, err := cli.BuildRouteMemo(context.Background(), clientCtx.TxConfig, clientCtx.Keyring, newHops())
+		require.NoError(t, err)
+
+		// the first layer is the tx of the first hop, it transfers to the
+		// signer of the second hop
+		tx, envelope := decodeMemoTx(t, clientCtx, memo)
+		require.True(t, envelope)
+		requireOnionSignature(t, clientCtx, tx, "chain-b", "alice", 1)
+		require.Len(t, tx.GetMsgs(), 1)
+		transfer, ok := tx.GetMsgs()[0].(*transfertypes.MsgTransfer)
+		require.True(t, ok)
+		require.Equal(t, transfertypes.PortID, transfer.SourcePort)
+		require.Equal(t, "channel-1", transfer.SourceChannel)
+		require.Equal(t, sdk.NewInt64Coin("uatom", 30), transfer.Token)
+		require.Equal(t, sdk.MustBech32ifyAddressBytes("b", alice), transfer.Sender)
+		require.Equal(t, sdk.MustBech32ifyAddressBytes("c", bob), transfer.Receiver)
+		require.Equal(t, clienttypes.NewHeight(1, 100), transfer.TimeoutHeight)
+
+		// the second layer runs its messages before the transfer to the last
+		// hop
+		tx, _ = decodeMemoTx(t, clientCtx, transfer.Memo)
+		requireOnionSignature(t, clientCtx, tx, "chain-c", "bob", 2)
+		require.Len(t, tx.GetMsgs(), 2)
+		require.Equal(t, newHops()[1].Msgs[0], tx.GetMsgs()[0])
+		transfer, ok = tx.GetMsgs()[1].(*transfertypes.MsgTransfer)
+		require.True(t, ok)
+		require.Equal(t, "custom", transfer.SourcePort)
+		require.Equal(t, "channel-2", transfer.SourceChannel)
+		require.Equal(t, sdk.MustBech32ifyAddressBytes("c", bob), transfer.Sender)
+		require.Equal(t, sdk.MustBech32ifyAddressBytes("d", carol), transfer.Receiver)
+		require.Equal(t, uint64(1_000), transfer.TimeoutTimestamp)
+
+		// the innermost layer is the tx of the last hop
+		tx, _ = decodeMemoTx(t, clientCtx, transfer.Memo)
+		requireOnionSignature(t, clientCtx, tx, "chain-d", "carol", 3)
+		require.Equal(t, []sdk.Msg{msgSend}, tx.GetMsgs())
+	})
+
+	specs := map[string]struct {
+		malleate func(hops []cli.RouteHop) []cli.RouteHop
+		expErr   string
+	}{
+		"no hops": {
+			malleate: func([]cli.RouteHop) []cli.RouteHop { return nil },
+			expErr:   "route has no hops",
+		},
+		"missing channel": {
+			malleate: func(hops []cli.RouteHop) []cli.RouteHop {
+				hops[1].Channel = ""
+				return hops
+			},
+			expErr: "hop 1: channel to the next hop is required",
+		},
+		"missing timeout": {
+			malleate: func(hops []cli.RouteHop) []cli.RouteHop {
+				hops[0].TimeoutHeight = clienttypes.ZeroHeight()
+				return hops
+			},
+			expErr: "hop 0: either a packet timeout height or timestamp is required",
+		},
+		"invalid token": {
+			malleate: func(hops []cli.RouteHop) []cli.RouteHop {
+				hops[0].Token = sdk.Coin{}
+				return hops
+			},
+			expErr: "hop 0: invalid token",
+		},
+		"last hop without messages": {
+			malleate: func(hops []cli.RouteHop) []cli.RouteHop {
+				hops[2].Msgs = nil
+				return hops
+			},
+			expErr: "hop 2: no messages",
+		},
+		"unknown key": {
+			malleate: func(hops []cli.RouteHop) []cli.RouteHop {
+				hops[2].Key = "dave"
+				return hops
+			},
+			expErr: "hop 2: dave",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			_, err := cli.BuildRouteMemo(context.Background(), clientCtx.TxConfig, clientCtx.Keyring, spec.malleate(newHops()))
+			require.ErrorContains(t, err, spec.expErr)
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+)
+
+// NewSignTxCmd returns a CLI command handler that signs the messages of an
+// unsigned tx as an onion tx.
+func NewSignTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign [file]",
+		Short: "Sign an unsigned tx as onion tx and print the memo carrying it",
+		Long: `Sign the messages of an unsigned tx, as produced by the --generate-only flag of
+any tx command, with the onion account number and the onion sequence of the
+--from key. The memo carrying the signed onion tx is printed.
+
+The gas limit, fees, note, timeout height and fee granter of the unsigned tx
+are kept unless they are overridden by their flags. Pass "-" as file to read
+the tx from stdin.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return signUnsignedTxFile(cmd, args[0])
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addOnionTxFlags(cmd)
+
+	return cmd
+}
+
+// signUnsignedTxFile signs the messages of the unsigned tx in filename as
+// onion tx and prints the memo carrying it.
+func signUnsignedTxFile(cmd *cobra.Command, filename string) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	clientCtx, msgs, err := readUnsignedTx(cmd, clientCtx, filename)
+	if err != nil {
+		return err
+	}
+
+	return WriteBase64Tx(clientCtx, cmd.Flags(), msgs...)
+}
+
+// readUnsignedTx reads the unsigned tx in filename and returns its messages
+// after applying its settings with applyUnsignedTx.
+func readUnsignedTx(cmd *cobra.Command, clientCtx client.Context, filename string) (client.Context, []sdk.Msg, error) {
+	unsignedTx, err := authclient.ReadTxFromFile(clientCtx, filename)
+	if err != nil {
+		return clientCtx, nil, err
+	}
+	if len(unsignedTx.GetMsgs()) == 0 {
+		return clientCtx, nil, errors.New("tx has no messages")
+	}
+
+	clientCtx, err = applyUnsignedTx(cmd, clientCtx, unsignedTx)
+	if err != nil {
+		return clientCtx, nil, err
+	}
+	return clientCtx, unsignedTx.GetMsgs(), nil
+}
+
+// applyUnsignedTx carries the settings of an unsigned tx over to the flags
+// and the client context, unless they were set explicitly.
+func applyUnsignedTx(cmd *cobra.Command, clientCtx client.Context, unsignedTx sdk.Tx) (client.Context, error) {
+	flagSet := cmd.Flags()
+	setFlag := func(name, value string) error {
+		if flagSet.Changed(name) {
+			return nil
+		}
+		return flagSet.Set(name, value)
+	}
+
+	if memoTx, ok := unsignedTx.(sdk.TxWithMemo); ok && memoTx.GetMemo() != "" {
+		if err := setFlag(flags.FlagNote, memoTx.GetMemo()); err != nil {
+			return clientCtx, err
+		}
+	}
+	if timeoutTx, ok := unsignedTx.(sdk.TxWithTimeoutHeight); ok && timeoutTx.GetTimeoutHeight() > 0 {
+		if err := setFlag(flags.FlagTimeoutHeight, strconv.FormatUint(timeoutTx.GetTimeoutHeight(), 10)); err != nil {
+			return clientCtx, err
+		}
+	}
+
+	feeTx, ok := unsignedTx.(sdk.FeeTx)
+	if !ok {
+		return clientCtx, nil
+	}
+	if feeTx.GetGas() > 0 {
+		if err := setFlag(flags.FlagGas, strconv.FormatUint(feeTx.GetGas(), 10)); err != nil {
+			return clientCtx, err
+		}
+	}
+	if !feeTx.GetFee().IsZero() && !flagSet.Changed(flags.FlagGasPrices) {
+		if err := setFlag(flags.FlagFees, feeTx.GetFee().String()); err != nil {
+			return clientCtx, err
+		}
+	}
+	if clientCtx.FeeGranter == nil && len(feeTx.FeeGranter()) > 0 {
+		clientCtx = clientCtx.WithFeeGranterAddress(feeTx.FeeGranter())
+	}
+	return clientCtx, nil
+}
@@ -0,0 +1,193 @@
+package cli_test
+
+import (
+	"context"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	txsigning "cosmossdk.io/x/tx/signing"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	app "onion/app"
+	"onion/x/onion/client/cli"
+	"onion/x/onion/types"
+)
+
+const testChainID = "onion-test"
+
+// newClientCtx returns an offline client context with the encoding of the
+// app and an in-memory keyring holding a key for each of names.
+func newClientCtx(t *testing.T, names ...string) client.Context {
+	onionApp := app.Setup(t, true)
+	kr := keyring.NewInMemory(onionApp.AppCodec())
+	for _, name := range names {
+		privKey := secp256k1.GenPrivKeyFromSecret([]byte(name))
+		require.NoError(t, kr.ImportPrivKeyHex(name, hex.EncodeToString(privKey.Bytes()), string(hd.Secp256k1Type)))
+	}
+
+	return client.Context{}.
+		WithCodec(onionApp.AppCodec()).
+		WithInterfaceRegistry(onionApp.AppCodec().InterfaceRegistry()).
+		WithTxConfig(onionApp.TxConfig()).
+		WithLegacyAmino(onionApp.LegacyAmino()).
+		WithAccountRetriever(authtypes.AccountRetriever{}).
+		WithKeyring(kr).
+		WithChainID(testChainID).
+		WithCmdContext(context.Background())
+}
+
+// keyAddress returns the address of the key name in the keyring of clientCtx.
+func keyAddress(t *testing.T, clientCtx client.Context, name string) sdk.AccAddress {
+	record, err := clientCtx.Keyring.Key(name)
+	require.NoError(t, err)
+	addr, err := record.GetAddress()
+	require.NoError(t, err)
+	return addr
+}
+
+// writeUnsignedTx writes msgs as unsigned tx JSON, as printed by
+// --generate-only, to a file and returns its path. edit is applied to the
+// builder first.
+func writeUnsignedTx(t *testing.T, clientCtx client.Context, edit func(client.TxBuilder), msgs ...sdk.Msg) string {
+	builder := clientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	builder.SetGasLimit(flags.DefaultGasLimit)
+	if edit != nil {
+		edit(builder)
+	}
+	bz, err := clientCtx.TxConfig.TxJSONEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	filename := filepath.Join(t.TempDir(), "unsigned_tx.json")
+	require.NoError(t, os.WriteFile(filename, bz, 0o600))
+	return filename
+}
+
+// decodeMemoTx parses the memo printed by a command and decodes its onion tx.
+func decodeMemoTx(t *testing.T, clientCtx client.Context, out string) (authsigning.Tx, bool) {
+	memo := strings.TrimSpace(out)
+	parsed, found, err := types.ParseMemo(memo, true)
+	require.NoError(t, err)
+	require.True(t, found)
+	tx, err := clientCtx.TxConfig.TxDecoder()(parsed.TxBytes)
+	require.NoError(t, err)
+	sigTx, ok := tx.(authsigning.Tx)
+	require.True(t, ok)
+	return sigTx, strings.HasPrefix(memo, "{")
+}
+
+// requireOnionSignature verifies that tx is signed for chainID by the single
+// key name of clientCtx with the onion account number and sequence seq.
+func requireOnionSignature(t *testing.T, clientCtx client.Context, tx authsigning.Tx, chainID, name string, seq uint64) {
+	requireOnionSignatures(t, clientCtx, tx, chainID, []sdk.AccAddress{keyAddress(t, clientCtx, name)}, []uint64{seq})
+}
+
+// requireOnionSignatures verifies that tx carries a valid signature for
+// chainID of each of signers in order, with the onion account number and the
+// sequence of the signer in seqs.
+func requireOnionSignatures(t *testing.T, clientCtx client.Context, tx authsigning.Tx, chainID string, signers []sdk.AccAddress, seqs []uint64) {
+	sigs, err := tx.GetSignaturesV2()
+	require.NoError(t, err)
+	require.Len(t, sigs, len(signers))
+	adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+	require.True(t, ok)
+	for i, sig := range sigs {
+		require.Equal(t, signers[i], sdk.AccAddress(sig.PubKey.Address()))
+		require.Equal(t, seqs[i], sig.Sequence)
+		anyPk, err := codectypes.NewAnyWithValue(sig.PubKey)
+		require.NoError(t, err)
+		signerData := txsigning.SignerData{
+			Address:       signers[i].String(),
+			ChainID:       chainID,
+			AccountNumber: types.AccountNumber,
+			Sequence:      sig.Sequence,
+			PubKey:        &anypb.Any{TypeUrl: anyPk.TypeUrl, Value: anyPk.Value},
+		}
+		require.NoError(t, authsigning.VerifySignature(
+			context.Background(), sig.PubKey, signerData, sig.Data,
+			clientCtx.TxConfig.SignModeHandler(), adaptableTx.GetSigningTxData(),
+		))
+	}
+}
+
+func TestSignTxCmd(t *testing.T) {
+	clientCtx := newClientCtx(t, "alice")
+	alice := keyAddress(t, clientCtx, "alice")
+	msgSend := banktypes.NewMsgSend(alice, sdk.AccAddress("recipient"), sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+	unsignedTx := writeUnsignedTx(t, clientCtx, func(b client.TxBuilder) {
+		b.SetGasLimit(300_000)
+		b.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+		b.SetMemo("note")
+		b.SetTimeoutHeight(100)
+	}, msgSend)
+
+	specs := map[string]struct {
+		args      []string
+		expGas    uint64
+		expFee    sdk.Coins
+		expLegacy bool
+		expErr    string
+	}{
+		"settings of the unsigned tx": {
+			expGas: 300_000,
+			expFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+		},
+		"flags override the unsigned tx": {
+			args:   []string{"--" + flags.FlagGas + "=200000", "--" + flags.FlagFees + "=20stake"},
+			expGas: 200_000,
+			expFee: sdk.NewCoins(sdk.NewInt64Coin("stake", 20)),
+		},
+		"legacy memo": {
+			args:      []string{"--" + cli.FlagLegacyMemo},
+			expGas:    300_000,
+			expFee:    sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+			expLegacy: true,
+		},
+		"unknown key": {
+			args:   []string{"--" + flags.FlagFrom + "=bob"},
+			expErr: "bob",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			args := append([]string{
+				unsignedTx,
+				"--" + flags.FlagFrom + "=alice",
+				"--" + flags.FlagOffline,
+				"--" + flags.FlagChainID + "=" + testChainID,
+				"--" + flags.FlagSequence + "=3",
+			}, spec.args...)
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewSignTxCmd(), args)
+			if spec.expErr != "" {
+				require.ErrorContains(t, err, spec.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			tx, envelope := decodeMemoTx(t, clientCtx, out.String())
+			require.Equal(t, !spec.expLegacy, envelope)
+			require.Equal(t, []sdk.Msg{msgSend}, tx.GetMsgs())
+			require.Equal(t, spec.expGas, tx.GetGas())
+			require.Equal(t, spec.expFee, tx.GetFee())
+			require.Equal(t, "note", tx.GetMemo())
+			require.Equal(t, uint64(100), tx.GetTimeoutHeight())
+			requireOnionSignature(t, clientCtx, tx, testChainID, "alice", 3)
+		})
+	}
+}
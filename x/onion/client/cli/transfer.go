@@ -0,0 +1,196 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+)
+
+const (
+	// FlagDestChainID sets the chain id the onion tx is signed for, it
+	// defaults to the chain id of the client.
+	FlagDestChainID = "dest-chain-id"
+	// FlagSender sets the sender of the MsgTransfer on the source chain.
+	FlagSender = "sender"
+	// FlagSourceKey names the keyring key that signs the MsgTransfer.
+	FlagSourceKey = "source-key"
+	// FlagSourcePrefix sets the bech32 prefix of the source chain accounts.
+	FlagSourcePrefix = "source-prefix"
+
+	// the source chain settings used to sign the MsgTransfer
+	FlagSourceChainID       = "source-chain-id"
+	FlagSourceAccountNumber = "source-account-number"
+	FlagSourceSequence      = "source-sequence"
+	FlagSourceFees          = "source-fees"
+	FlagSourceGas           = "source-gas"
+
+	// the timeouts of the packet, as for ibc-transfer
+	FlagPacketTimeoutHeight    = "packet-timeout-height"
+	FlagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+)
+
+// NewTransferTxCmd returns a CLI command handler that builds the ICS-20
+// transfer of the source chain carrying an onion tx for this chain.
+func NewTransferTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [src-port] [src-channel] [receiver] [amount] [unsigned-tx-file]",
+		Short: "Build the source chain MsgTransfer carrying the messages of an unsigned tx as onion tx",
+		Long: `Sign the messages of an unsigned tx as onion tx for --dest-chain-id, like the
+sign command does, and build a MsgTransfer of the source chain carrying the
+onion memo. [amount] is sent to [receiver] on this chain through the source
+chain's [src-port] and [src-channel].
+
+The transfer tx is printed as unsigned JSON. With --source-key it is signed
+for --source-chain-id with --source-account-number and --source-sequence, so
+it can be broadcast on the source chain right away. The sender is --sender or
+the address of --source-key with --source-prefix.
+
+The standard tx flags apply to the onion tx. In offline mode --sequence is used
+as onion sequence, no node is contacted.
+`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if destChainID, _ := cmd.Flags().GetString(FlagDestChainID); destChainID != "" {
+				clientCtx = clientCtx.WithChainID(destChainID)
+			}
+
+			token, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, msgs, err := readUnsignedTx(cmd, clientCtx, args[4])
+			if err != nil {
+				return err
+			}
+
+			memo, err := BuildOnionMemo(clientCtx, cmd.Flags(), msgs...)
+			if err != nil || memo == "" {
+				return err
+			}
+
+			return writeSourceTransferTx(cmd, clientCtx, args[0], args[1], args[2], token, memo)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addOnionTxFlags(cmd)
+	cmd.Flags().String(FlagDestChainID, "", "Chain id the onion tx is signed for, defaults to --chain-id")
+	cmd.Flags().String(FlagSender, "", "Sender of the transfer on the source chain")
+	cmd.Flags().String(FlagSourceKey, "", "Keyring key signing the transfer on the source chain, the transfer is printed unsigned without it")
+	cmd.Flags().String(FlagSourcePrefix, "", "Bech32 account prefix of the source chain, defaults to the prefix of this chain")
+	cmd.Flags().String(FlagSourceChainID, "", "Chain id of the source chain, required with --source-key")
+	cmd.Flags().Uint64(FlagSourceAccountNumber, 0, "Account number of the sender on the source chain, required with --source-key")
+	cmd.Flags().Uint64(FlagSourceSequence, 0, "Sequence of the sender on the source chain, required with --source-key")
+	cmd.Flags().String(FlagSourceFees, "", "Fees of the transfer on the source chain")
+	cmd.Flags().Uint64(FlagSourceGas, flags.DefaultGasLimit, "Gas limit of the transfer on the source chain")
+	cmd.Flags().String(FlagPacketTimeoutHeight, "0-0", "Absolute packet timeout height of this chain in the format {revision}-{height}")
+	cmd.Flags().Uint64(FlagPacketTimeoutTimestamp, transfertypes.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds relative to the local clock, 0 disables it")
+
+	return cmd
+}
+
+// writeSourceTransferTx prints the source chain tx transferring token to
+// receiver with the onion memo, signed when --source-key is set.
+func writeSourceTransferTx(cmd *cobra.Command, clientCtx client.Context, srcPort, srcChannel, receiver string, token sdk.Coin, memo string) error {
+	flagSet := cmd.Flags()
+
+	sourceKey, _ := flagSet.GetString(FlagSourceKey)
+	sender, _ := flagSet.GetString(FlagSender)
+	if sourceKey != "" {
+		record, err := clientCtx.Keyring.Key(sourceKey)
+		if err != nil {
+			return err
+		}
+		addr, err := record.GetAddress()
+		if err != nil {
+			return err
+		}
+		prefix, _ := flagSet.GetString(FlagSourcePrefix)
+		if prefix == "" {
+			prefix = sdk.GetConfig().GetBech32AccountAddrPrefix()
+		}
+		keySender, err := sdk.Bech32ifyAddressBytes(prefix, addr)
+		if err != nil {
+			return err
+		}
+		if sender != "" && sender != keySender {
+			return fmt.Errorf("sender %s does not match the address %s of the source key", sender, keySender)
+		}
+		sender = keySender
+	}
+	if sender == "" {
+		return errors.New("either --sender or --source-key is required")
+	}
+
+	timeoutHeightStr, _ := flagSet.GetString(FlagPacketTimeoutHeight)
+	timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+	if err != nil {
+		return err
+	}
+	timeoutTimestamp, _ := flagSet.GetUint64(FlagPacketTimeoutTimestamp)
+	if timeoutTimestamp != 0 {
+		timeoutTimestamp += uint64(time.Now().UnixNano())
+	}
+	if timeoutHeight.IsZero() && timeoutTimestamp == 0 {
+		return errors.New("either a packet timeout height or timestamp is required")
+	}
+
+	// the sender has the prefix of the source chain, the msg is validated
+	// there
+	msg := transfertypes.NewMsgTransfer(srcPort, srcChannel, token, sender, receiver, timeoutHeight, timeoutTimestamp, memo)
+
+	txBuilder := clientCtx.TxConfig.NewTxBuilder()
+	if err := txBuilder.SetMsgs(msg); err != nil {
+		return err
+	}
+	gas, _ := flagSet.GetUint64(FlagSourceGas)
+	txBuilder.SetGasLimit(gas)
+	feesStr, _ := flagSet.GetString(FlagSourceFees)
+	fees, err := sdk.ParseCoinsNormalized(feesStr)
+	if err != nil {
+		return err
+	}
+	txBuilder.SetFeeAmount(fees)
+
+	if sourceKey != "" {
+		if !flagSet.Changed(FlagSourceAccountNumber) || !flagSet.Changed(FlagSourceSequence) {
+			return errors.New("--source-account-number and --source-sequence are required with --source-key")
+		}
+		sourceChainID, _ := flagSet.GetString(FlagSourceChainID)
+		if sourceChainID == "" {
+			return errors.New("--source-chain-id is required with --source-key")
+		}
+		accNum, _ := flagSet.GetUint64(FlagSourceAccountNumber)
+		seq, _ := flagSet.GetUint64(FlagSourceSequence)
+
+		txf := sdktx.Factory{}.
+			WithTxConfig(clientCtx.TxConfig).
+			WithKeybase(clientCtx.Keyring).
+			WithChainID(sourceChainID).
+			WithAccountNumber(accNum).
+			WithSequence(seq)
+		if err := sdktx.Sign(clientCtx.CmdContext, txf, sourceKey, txBuilder, true); err != nil {
+			return err
+		}
+	}
+
+	json, err := clientCtx.TxConfig.TxJSONEncoder()(txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+	return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+}
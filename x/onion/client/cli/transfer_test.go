@@ -0,0 +1,113 @@
+package cli_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+
+	"onion/x/onion/client/cli"
+)
+
+func TestTransferTxCmd(t *testing.T) {
+	clientCtx := newClientCtx(t, "alice", "relayer")
+	alice := keyAddress(t, clientCtx, "alice")
+	relayer := keyAddress(t, clientCtx, "relayer")
+	msgSend := banktypes.NewMsgSend(alice, sdk.AccAddress("recipient"), sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	unsignedTx := writeUnsignedTx(t, clientCtx, nil, msgSend)
+
+	specs := map[string]struct {
+		args      []string
+		expSender string
+		expSigned bool
+		expErr    string
+	}{
+		"unsigned with sender": {
+			args:      []string{"--" + cli.FlagSender + "=source1sender"},
+			expSender: "source1sender",
+		},
+		"signed with source key": {
+			args: []string{
+				"--" + cli.FlagSourceKey + "=relayer",
+				"--" + cli.FlagSourcePrefix + "=source",
+				"--" + cli.FlagSourceChainID + "=source-chain",
+				"--" + cli.FlagSourceAccountNumber + "=1",
+				"--" + cli.FlagSourceSequence + "=2",
+			},
+			expSender: sdk.MustBech32ifyAddressBytes("source", relayer),
+			expSigned: true,
+		},
+		"source key without source sequence": {
+			args: []string{
+				"--" + cli.FlagSourceKey + "=relayer",
+				"--" + cli.FlagSourceChainID + "=source-chain",
+				"--" + cli.FlagSourceAccountNumber + "=1",
+			},
+			expErr: "--source-sequence are required",
+		},
+		"no sender": {
+			expErr: "either --sender or --source-key is required",
+		},
+		"no timeout": {
+			args: []string{
+				"--" + cli.FlagSender + "=source1sender",
+				"--" + cli.FlagPacketTimeoutTimestamp + "=0",
+				"--" + cli.FlagPacketTimeoutHeight + "=0-0",
+			},
+			expErr: "either a packet timeout height or timestamp is required",
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			args := append([]string{
+				"transfer", "channel-0", alice.String(), "100uatom", unsignedTx,
+				"--" + flags.FlagFrom + "=alice",
+				"--" + flags.FlagOffline,
+				"--" + flags.FlagChainID + "=other-chain",
+				"--" + cli.FlagDestChainID + "=" + testChainID,
+				"--" + flags.FlagSequence + "=4",
+				"--" + cli.FlagPacketTimeoutHeight + "=1-1000",
+			}, spec.args...)
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.NewTransferTxCmd(), args)
+			if spec.expErr != "" {
+				require.ErrorContains(t, err, spec.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			tx, err := clientCtx.TxConfig.TxJSONDecoder()(out.Bytes())
+			require.NoError(t, err)
+			require.Len(t, tx.GetMsgs(), 1)
+			msgTransfer, ok := tx.GetMsgs()[0].(*transfertypes.MsgTransfer)
+			require.True(t, ok)
+			require.Equal(t, "transfer", msgTransfer.SourcePort)
+			require.Equal(t, "channel-0", msgTransfer.SourceChannel)
+			require.Equal(t, sdk.NewInt64Coin("uatom", 100), msgTransfer.Token)
+			require.Equal(t, spec.expSender, msgTransfer.Sender)
+			require.Equal(t, alice.String(), msgTransfer.Receiver)
+			require.Equal(t, clienttypes.NewHeight(1, 1000), msgTransfer.TimeoutHeight)
+
+			// the memo carries the onion tx signed for the destination chain
+			onionTx, envelope := decodeMemoTx(t, clientCtx, msgTransfer.Memo)
+			require.True(t, envelope)
+			require.Equal(t, []sdk.Msg{msgSend}, onionTx.GetMsgs())
+			requireOnionSignature(t, clientCtx, onionTx, testChainID, "alice", 4)
+
+			sigs, err := tx.(authsigning.Tx).GetSignaturesV2()
+			require.NoError(t, err)
+			if !spec.expSigned {
+				require.Empty(t, sigs)
+				return
+			}
+			require.Len(t, sigs, 1)
+			require.Equal(t, uint64(2), sigs[0].Sequence)
+			require.Equal(t, relayer, sdk.AccAddress(sigs[0].PubKey.Address()))
+		})
+	}
+}
@@ -2,10 +2,11 @@ package cli
 
 import (
 	"context"
-	"encoding/base64"
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -15,10 +16,50 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
+const (
+	// FlagLegacyMemo makes WriteBase64Tx print the raw base64 tx instead of
+	// the JSON memo envelope.
+	FlagLegacyMemo = "legacy-memo"
+	// FlagDeadline sets a signed deadline, relative to now, after which the
+	// onion tx is rejected.
+	FlagDeadline = "deadline"
+	// FlagUnordered signs the onion tx with the --sequence value as unordered
+	// nonce instead of the next onion sequence of the signer.
+	FlagUnordered = "unordered"
+	// FlagFunds sets the funds the transfer carrying the memo delivers to the
+	// signer, used when estimating gas with --gas auto.
+	FlagFunds = "funds"
+	// FlagFundsChannel sets the channel of the transfer port the transfer is
+	// received on, the funds are credited like by a packet received on it.
+	FlagFundsChannel = "funds-channel"
+	// FlagRecoveryAddress and FlagRecoveryReturnReceiver sign a recovery
+	// instruction into the onion tx, the funds the transfer delivered are
+	// sent to the address, or transferred back to the receiver on the source
+	// chain, when the tx fails.
+	FlagRecoveryAddress        = "recovery-address"
+	FlagRecoveryReturnReceiver = "recovery-return-receiver"
+	// FlagRecoveryReturnTimeout sets the timeout of the return transfer
+	// relative to the block time it is sent at.
+	FlagRecoveryReturnTimeout = "recovery-return-timeout"
+	// FlagBindReceiver and FlagSpendLimit sign a packet binding into the
+	// onion tx, it then only runs when the signer is the receiver of the
+	// transfer, or only spends the funds the transfer delivered.
+	FlagBindReceiver = "bind-receiver"
+	FlagSpendLimit   = "spend-limit"
+	// FlagReceivedAmount signs the opt-in to replace the single coin of the
+	// messages in the onion/received placeholder denom with the funds the
+	// transfer delivered, its amount is the minimum it must deliver.
+	FlagReceivedAmount = "received-amount"
+	// FlagRetry signs the opt-in to keep the onion tx in the retry queue when
+	// it fails during execution.
+	FlagRetry = "retry"
+)
+
 // NewTxCmd returns a root CLI command handler for all x/bank transaction commands.
 func NewTxCmd() *cobra.Command {
 	txCmd := &cobra.Command{
@@ -31,6 +72,13 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewSendTxCmd(),
+		NewSignTxCmd(),
+		NewTransferTxCmd(),
+		NewRouteTxCmd(),
+		NewSignPartialTxCmd(),
+		NewMultisignTxCmd(),
+		NewAssembleTxCmd(),
+		NewExecuteTxCmd(),
 	)
 
 	return txCmd
@@ -74,66 +122,286 @@ When using '--dry-run' a key name cannot be used, only a bech32 address.
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	addOnionTxFlags(cmd)
 
 	return cmd
 }
 
+// addOnionTxFlags adds the flags read by WriteBase64Tx to cmd, except those
+// cmd already defines itself.
+func addOnionTxFlags(cmd *cobra.Command) {
+	flagSet := cmd.Flags()
+	if flagSet.Lookup(FlagLegacyMemo) == nil {
+		flagSet.Bool(FlagLegacyMemo, false, "Print the raw base64 encoded tx instead of the JSON memo envelope")
+	}
+	if flagSet.Lookup(FlagDeadline) == nil {
+		flagSet.Duration(FlagDeadline, 0, "Reject the onion tx when it is executed later than this duration from now, e.g. 24h")
+	}
+	if flagSet.Lookup(FlagUnordered) == nil {
+		flagSet.Bool(FlagUnordered, false, "Use --sequence as unordered nonce, the tx may then be executed in any order relative to other unordered txs of the signer")
+	}
+	if flagSet.Lookup(FlagFunds) == nil {
+		flagSet.String(FlagFunds, "", "Funds the transfer delivers to the signer, credited when estimating gas with --gas auto")
+	}
+	if flagSet.Lookup(FlagFundsChannel) == nil {
+		flagSet.String(FlagFundsChannel, "", "Channel the transfer delivering --funds is received on")
+	}
+	if flagSet.Lookup(FlagRecoveryAddress) == nil {
+		flagSet.String(FlagRecoveryAddress, "", "Send the funds the transfer delivers to this address when the onion tx fails, the signer must be the transfer receiver")
+	}
+	if flagSet.Lookup(FlagRecoveryReturnReceiver) == nil {
+		flagSet.String(FlagRecoveryReturnReceiver, "", "Transfer the funds the transfer delivers back to this source chain address when the onion tx fails, the signer must be the transfer receiver")
+	}
+	if flagSet.Lookup(FlagRecoveryReturnTimeout) == nil {
+		flagSet.Duration(FlagRecoveryReturnTimeout, 0, "Timeout of the recovery return transfer, the ICS-20 default when 0")
+	}
+	if flagSet.Lookup(FlagBindReceiver) == nil {
+		flagSet.Bool(FlagBindReceiver, false, "Only execute the onion tx when the signer is the receiver of the transfer carrying it")
+	}
+	if flagSet.Lookup(FlagSpendLimit) == nil {
+		flagSet.Bool(FlagSpendLimit, false, "Fail the onion tx when it spends more than the funds the transfer carrying it delivered, fees included")
+	}
+	if flagSet.Lookup(FlagReceivedAmount) == nil {
+		flagSet.Bool(FlagReceivedAmount, false, "Replace the one coin in the "+types.ReceivedAmountDenom+" denom, e.g. 1"+types.ReceivedAmountDenom+", with the funds the transfer carrying the onion tx delivered")
+	}
+	if flagSet.Lookup(FlagRetry) == nil {
+		flagSet.Bool(FlagRetry, false, "Keep the onion tx in the retry queue when it fails during execution, it may then be retried with retry-onion")
+	}
+}
+
+// WriteBase64Tx signs msgs as an onion tx and prints the ICS-20 memo
+// carrying it, either as JSON envelope or, with --legacy-memo, as raw base64.
 func WriteBase64Tx(clientCtx client.Context, flagSet *pflag.FlagSet, msgs ...sdk.Msg) error {
-	txf, err := sdktx.NewFactoryCLI(clientCtx, flagSet)
-	if err != nil {
+	memo, err := BuildOnionMemo(clientCtx, flagSet, msgs...)
+	if err != nil || memo == "" {
 		return err
 	}
+	return clientCtx.PrintString(fmt.Sprintf("%s\n", memo))
+}
+
+// BuildOnionMemo signs msgs as an onion tx for the chain of clientCtx and
+// returns the ICS-20 memo carrying it. In offline mode --sequence is used as
+// onion sequence. With --dry-run only the gas estimate is printed and the
+// returned memo is empty.
+func BuildOnionMemo(clientCtx client.Context, flagSet *pflag.FlagSet, msgs ...sdk.Msg) (string, error) {
+	txf, err := newOnionFactoryCLI(clientCtx, flagSet)
+	if err != nil {
+		return "", err
+	}
 
 	txf, err = txf.Prepare(clientCtx)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	queryClient := types.NewQueryClient(clientCtx)
-	newSeq := uint64(0)
-	res, err := queryClient.Sequence(context.Background(), &types.QuerySequenceRequest{
-		Address: clientCtx.GetFromAddress().String(),
-	})
-	if err == nil {
-		newSeq = res.Seq.Sequence
+	var extOptions []*codectypes.Any
+	if unordered, _ := flagSet.GetBool(FlagUnordered); unordered {
+		option, err := codectypes.NewAnyWithValue(&types.ExtensionOptionUnorderedNonce{})
+		if err != nil {
+			return "", err
+		}
+		extOptions = append(extOptions, option)
+
+		// Prepare fills an unset sequence with the account sequence
+		nonce, err := flagSet.GetUint64(flags.FlagSequence)
+		if err != nil {
+			return "", err
+		}
+		txf = txf.WithSequence(nonce)
+	} else if !clientCtx.Offline {
+		queryClient := types.NewQueryClient(clientCtx)
+		newSeq := uint64(0)
+		res, err := queryClient.Sequence(context.Background(), &types.QuerySequenceRequest{
+			Address: clientCtx.GetFromAddress().String(),
+		})
+		if err == nil {
+			newSeq = res.Seq.Sequence
+		}
+		txf = txf.WithSequence(newSeq)
 	}
-	txf = txf.WithSequence(newSeq)
 	txf = txf.WithAccountNumber(types.AccountNumber)
 
+	if deadline, _ := flagSet.GetDuration(FlagDeadline); deadline > 0 {
+		option, err := codectypes.NewAnyWithValue(&types.ExtensionOptionDeadline{
+			Deadline: time.Now().Add(deadline).UTC(),
+		})
+		if err != nil {
+			return "", err
+		}
+		extOptions = append(extOptions, option)
+	}
+	recovery, err := readRecoveryFlags(flagSet)
+	if err != nil {
+		return "", err
+	}
+	if recovery != nil {
+		option, err := codectypes.NewAnyWithValue(recovery)
+		if err != nil {
+			return "", err
+		}
+		extOptions = append(extOptions, option)
+	}
+	bindReceiver, _ := flagSet.GetBool(FlagBindReceiver)
+	limitSpend, _ := flagSet.GetBool(FlagSpendLimit)
+	if bindReceiver || limitSpend {
+		option, err := codectypes.NewAnyWithValue(&types.ExtensionOptionPacketBinding{
+			Receiver:   bindReceiver,
+			SpendLimit: limitSpend,
+		})
+		if err != nil {
+			return "", err
+		}
+		extOptions = append(extOptions, option)
+	}
+	if receivedAmount, _ := flagSet.GetBool(FlagReceivedAmount); receivedAmount {
+		option, err := codectypes.NewAnyWithValue(&types.ExtensionOptionReceivedAmount{})
+		if err != nil {
+			return "", err
+		}
+		extOptions = append(extOptions, option)
+	}
+	if retry, _ := flagSet.GetBool(FlagRetry); retry {
+		option, err := codectypes.NewAnyWithValue(&types.ExtensionOptionRetry{})
+		if err != nil {
+			return "", err
+		}
+		extOptions = append(extOptions, option)
+	}
+	txf = txf.WithExtensionOptions(extOptions...)
+
 	if txf.SimulateAndExecute() || clientCtx.Simulate {
 		if clientCtx.Offline {
-			return errors.New("cannot estimate gas in offline mode")
+			return "", errors.New("cannot estimate gas in offline mode")
 		}
 
-		_, adjusted, err := sdktx.CalculateGas(clientCtx, txf, msgs...)
+		gas, err := estimateOnionGas(clientCtx, flagSet, txf, msgs...)
 		if err != nil {
-			return err
+			return "", err
 		}
 
-		txf = txf.WithGas(adjusted)
+		txf = txf.WithGas(gas)
 		_, _ = fmt.Fprintf(os.Stderr, "%s\n", sdktx.GasEstimateResponse{GasEstimate: txf.Gas()})
 	}
 
 	if clientCtx.Simulate {
-		return nil
+		return "", nil
+	}
+
+	txBytes, err := signOnionTx(clientCtx, txf, msgs...)
+	if err != nil {
+		return "", err
+	}
+
+	return newOnionMemo(flagSet, txBytes)
+}
+
+// readRecoveryFlags returns the recovery instruction set by the recovery
+// flags, nil if none is set.
+func readRecoveryFlags(flagSet *pflag.FlagSet) (*types.ExtensionOptionRecovery, error) {
+	address, _ := flagSet.GetString(FlagRecoveryAddress)
+	returnReceiver, _ := flagSet.GetString(FlagRecoveryReturnReceiver)
+	if address == "" && returnReceiver == "" {
+		return nil, nil
+	}
+	returnTimeout, _ := flagSet.GetDuration(FlagRecoveryReturnTimeout)
+	if returnTimeout < 0 {
+		return nil, fmt.Errorf("negative --%s", FlagRecoveryReturnTimeout)
 	}
 
+	recovery := &types.ExtensionOptionRecovery{
+		Address:        address,
+		ReturnReceiver: returnReceiver,
+		ReturnTimeout:  uint64(returnTimeout),
+	}
+	if err := recovery.Validate(); err != nil {
+		return nil, err
+	}
+	return recovery, nil
+}
+
+// newOnionMemo returns the memo carrying txBytes, either as JSON envelope or,
+// with --legacy-memo, as raw base64.
+func newOnionMemo(flagSet *pflag.FlagSet, txBytes []byte) (string, error) {
+	if legacyMemo, _ := flagSet.GetBool(FlagLegacyMemo); legacyMemo {
+		return types.NewLegacyMemo(txBytes), nil
+	}
+	return types.NewMemo(txBytes)
+}
+
+// newOnionFactoryCLI is like tx.NewFactoryCLI but does not require
+// --account-number in offline mode, onion txs use a fixed account number.
+func newOnionFactoryCLI(clientCtx client.Context, flagSet *pflag.FlagSet) (sdktx.Factory, error) {
+	if clientCtx.Offline && !flagSet.Changed(flags.FlagAccountNumber) {
+		if err := flagSet.Set(flags.FlagAccountNumber, strconv.FormatUint(types.AccountNumber, 10)); err != nil {
+			return sdktx.Factory{}, err
+		}
+	}
+	return sdktx.NewFactoryCLI(clientCtx, flagSet)
+}
+
+// signOnionTx builds msgs into a tx signed by the --from key and encodes it.
+func signOnionTx(clientCtx client.Context, txf sdktx.Factory, msgs ...sdk.Msg) ([]byte, error) {
 	tx, err := txf.BuildUnsignedTx(msgs...)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	err = sdktx.Sign(clientCtx.CmdContext, txf, clientCtx.GetFromName(), tx, true)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	txBytes, err := clientCtx.TxConfig.TxEncoder()(tx.GetTx())
+	return clientCtx.TxConfig.TxEncoder()(tx.GetTx())
+}
+
+// estimateOnionGas signs msgs with the max onion gas and runs them through
+// the SimulateOnion query. The gas used, times the gas adjustment and capped
+// at the max onion gas, is returned.
+func estimateOnionGas(clientCtx client.Context, flagSet *pflag.FlagSet, txf sdktx.Factory, msgs ...sdk.Msg) (uint64, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+	paramsRes, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
 	if err != nil {
-		return err
+		return 0, err
+	}
+	maxGas := paramsRes.Params.MaxGas
+
+	var funds sdk.Coins
+	if fundsStr, _ := flagSet.GetString(FlagFunds); fundsStr != "" {
+		funds, err = sdk.ParseCoinsNormalized(fundsStr)
+		if err != nil {
+			return 0, err
+		}
+	}
+	channelID, _ := flagSet.GetString(FlagFundsChannel)
+	if !funds.Empty() && channelID == "" {
+		return 0, fmt.Errorf("--%s is required with --%s", FlagFundsChannel, FlagFunds)
 	}
 
-	txBase64Encoding := base64.StdEncoding.EncodeToString(txBytes)
-	fmt.Println(txBase64Encoding)
-	return nil
+	txBytes, err := signOnionTx(clientCtx, txf.WithGas(maxGas), msgs...)
+	if err != nil {
+		return 0, err
+	}
+	memo, err := types.NewMemo(txBytes)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := queryClient.SimulateOnion(context.Background(), &types.QuerySimulateOnionRequest{
+		Memo:      memo,
+		Receiver:  clientCtx.GetFromAddress().String(),
+		Funds:     funds,
+		ChannelId: channelID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if !res.Success {
+		return 0, fmt.Errorf("onion tx simulation failed at %s stage: %s", res.FailedStage, res.Error)
+	}
+
+	gas := uint64(txf.GasAdjustment() * float64(res.GasUsed))
+	if gas > maxGas {
+		gas = maxGas
+	}
+	return gas, nil
 }
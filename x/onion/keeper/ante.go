@@ -1,8 +1,8 @@
 package keeper
 
 import (
-	"bytes"
 	"fmt"
+	"time"
 
 	"onion/x/onion/types"
 
@@ -60,38 +60,41 @@ func OnlyLegacyAminoSigners(sigData signing.SignatureData) bool {
 	}
 }
 
-func (k Keeper) ExecuteAnte(ctx sdk.Context, tx sdk.Tx) error {
-	// ValidateBasicDecorator
-	if validateBasic, ok := tx.(sdk.HasValidateBasic); ok {
-		if err := validateBasic.ValidateBasic(); err != nil {
-			return err
-		}
+// ExecuteAnte runs the ante checks of an onion tx, the fee is paid to the
+// relayer that delivered the packet carrying it.
+func (k Keeper) ExecuteAnte(ctx sdk.Context, tx sdk.Tx, relayer sdk.AccAddress) error {
+	_, err := k.executeAnte(ctx, tx, nil, relayer, false)
+	return err
+}
+
+// executeAnte runs CheckOnionTx on tx and, unless anteDone, applies the ante
+// state changes once the checks pass: the fee is paid to relayer, the
+// pubkeys are set on the signer accounts and the onion sequences or
+// unordered nonces are used. The failed check is returned with the error.
+func (k Keeper) executeAnte(ctx sdk.Context, tx sdk.Tx, memoDeadline *time.Time, relayer sdk.AccAddress, anteDone bool) (OnionTxCheck, error) {
+	if check, err := CheckOnionTx(ctx, k.TxState(ctx), k.SignModeHandler, tx, memoDeadline, anteDone); err != nil || anteDone {
+		return check, err
 	}
 
-	// SetPubKeyDecorator
-	sigTx, ok := tx.(authsigning.SigVerifiableTx)
-	if !ok {
-		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid tx type")
+	// DeductFeeDecorator
+	if err := k.DeductTxFee(ctx, tx.(sdk.FeeTx), relayer); err != nil {
+		return OnionTxCheckFee, err
 	}
 
-	pubkeys, err := sigTx.GetPubKeys()
+	// SetPubKeyDecorator
+	sigTx := tx.(authsigning.SigVerifiableTx)
+	pubKeys, err := sigTx.GetPubKeys()
 	if err != nil {
-		return err
+		return "", err
 	}
 	signers, err := sigTx.GetSigners()
 	if err != nil {
-		return err
+		return "", err
 	}
-
-	for i, pk := range pubkeys {
+	for i, pk := range pubKeys {
 		if pk == nil {
 			continue
 		}
-		if !bytes.Equal(pk.Address(), signers[i]) {
-			return errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey,
-				"pubKey does not match signer address %s with signer index: %d", signers[i], i)
-		}
-
 		acc, err := GetSignerAcc(ctx, k.accountKeeper, signers[i])
 		if err != nil {
 			acc = k.accountKeeper.NewAccountWithAddress(ctx, signers[i])
@@ -100,101 +103,34 @@ func (k Keeper) ExecuteAnte(ctx sdk.Context, tx sdk.Tx) error {
 		if acc.GetPubKey() != nil {
 			continue
 		}
-		err = acc.SetPubKey(pk)
-		if err != nil {
-			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+		if err := acc.SetPubKey(pk); err != nil {
+			return OnionTxCheckPubKey, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
 		}
 		k.accountKeeper.SetAccount(ctx, acc)
 	}
 
-	// ValidateSigCountDecorator
-	params := k.accountKeeper.GetParams(ctx)
-	pubKeys, err := sigTx.GetPubKeys()
-	if err != nil {
-		return err
-	}
-
-	sigCount := 0
-	for _, pk := range pubKeys {
-		sigCount += CountSubKeys(pk)
-		if uint64(sigCount) > params.TxSigLimit {
-			return errorsmod.Wrapf(sdkerrors.ErrTooManySignatures,
-				"signatures: %d, limit: %d", sigCount, params.TxSigLimit)
-		}
-	}
-
-	// SigVerificationDecorator
+	// IncrementSequenceDecorator
 	sigs, err := sigTx.GetSignaturesV2()
 	if err != nil {
-		return err
-	}
-
-	signerAddrs, err := sigTx.GetSigners()
-	if err != nil {
-		return err
-	}
-
-	if len(sigs) != len(signerAddrs) {
-		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
-	}
-
-	for i, sig := range sigs {
-		acc, err := GetSignerAcc(ctx, k.accountKeeper, signerAddrs[i])
-		if err != nil {
-			return err
-		}
-
-		pubKey := acc.GetPubKey()
-		if pubKey == nil {
-			return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
-		}
-
-		onionSeq := uint64(0)
-		seq, err := k.GetSequence(ctx, acc.GetAddress().String())
-		if err == nil {
-			onionSeq = seq.Sequence
-		}
-
-		if sig.Sequence != onionSeq {
-			return errorsmod.Wrapf(
-				sdkerrors.ErrWrongSequence,
-				"onion sequence mismatch, expected %d, got %d", onionSeq, sig.Sequence,
-			)
-		}
-
-		chainID := ctx.ChainID()
-		accNum := types.AccountNumber
-		anyPk, _ := codectypes.NewAnyWithValue(pubKey)
-		signerData := txsigning.SignerData{
-			Address:       acc.GetAddress().String(),
-			ChainID:       chainID,
-			AccountNumber: accNum,
-			Sequence:      acc.GetSequence(),
-			PubKey: &anypb.Any{
-				TypeUrl: anyPk.TypeUrl,
-				Value:   anyPk.Value,
-			},
-		}
-
-		adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
-		if !ok {
-			return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
-		}
-		txData := adaptableTx.GetSigningTxData()
-		err = authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, k.SignModeHandler, txData)
-		if err != nil {
-			var errMsg string
-			if OnlyLegacyAminoSigners(sig.Data) {
-				errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, acc.GetSequence(), chainID)
-			} else {
-				errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s)", accNum, chainID)
+		return "", err
+	}
+	unordered := IsUnorderedTx(tx)
+	if unordered {
+		for i, addr := range signers {
+			nonces, err := k.GetUnorderedNonces(ctx, sdk.AccAddress(addr).String())
+			if err != nil {
+				return "", err
+			}
+			if err := nonces.Use(sigs[i].Sequence); err != nil {
+				return OnionTxCheckSequence, err
+			}
+			if err := k.SetUnorderedNonces(ctx, nonces); err != nil {
+				return "", err
 			}
-			return errorsmod.Wrap(sdkerrors.ErrUnauthorized, errMsg)
 		}
 	}
 
-	// IncrementSequenceDecorator
-	for _, addr := range signerAddrs {
+	for _, addr := range signers {
 		seq, err := k.GetSequence(ctx, sdk.AccAddress(addr).String())
 		if err != nil {
 			seq = types.OnionSequence{
@@ -203,12 +139,57 @@ func (k Keeper) ExecuteAnte(ctx sdk.Context, tx sdk.Tx) error {
 			}
 		}
 
-		seq.Sequence++
-		err = k.SetSequence(ctx, seq)
-		if err != nil {
-			return err
+		// unordered txs only record the activity of the signer
+		if !unordered {
+			seq.Sequence++
+		}
+		seq.LastUsedHeight = ctx.BlockHeight()
+		if err := k.SetSequence(ctx, seq); err != nil {
+			return "", err
 		}
 	}
 
+	return "", nil
+}
+
+// verifySignature verifies the signature sig of the signer addr with pubKey
+// over tx. The signature covers the onion account number and the onion
+// sequence or unordered nonce of sig, which the caller checks. Sign modes
+// that sign over the sequence, like amino json used by multisigs, rely on
+// it.
+func (k Keeper) verifySignature(ctx sdk.Context, tx sdk.Tx, addr string, pubKey cryptotypes.PubKey, sig signing.SignatureV2) error {
+	return verifySignature(ctx, k.SignModeHandler, tx, addr, pubKey, sig)
+}
+
+func verifySignature(ctx sdk.Context, signModeHandler *txsigning.HandlerMap, tx sdk.Tx, addr string, pubKey cryptotypes.PubKey, sig signing.SignatureV2) error {
+	chainID := ctx.ChainID()
+	accNum := types.AccountNumber
+	anyPk, _ := codectypes.NewAnyWithValue(pubKey)
+	signerData := txsigning.SignerData{
+		Address:       addr,
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      sig.Sequence,
+		PubKey: &anypb.Any{
+			TypeUrl: anyPk.TypeUrl,
+			Value:   anyPk.Value,
+		},
+	}
+
+	adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
+	}
+	txData := adaptableTx.GetSigningTxData()
+	err := authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, signModeHandler, txData)
+	if err != nil {
+		var errMsg string
+		if OnlyLegacyAminoSigners(sig.Data) {
+			errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, sig.Sequence, chainID)
+		} else {
+			errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s)", accNum, chainID)
+		}
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, errMsg)
+	}
 	return nil
 }
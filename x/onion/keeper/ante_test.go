@@ -71,7 +71,7 @@ func (s *KeeperTestSuite) TestExecuteAnte() {
 			s.SetupTest()
 			s.Ctx = s.Ctx.WithChainID("test")
 			tx := newTx(s.T(), s.App.TxConfig(), addr1, s.Ctx.ChainID(), spec.accNum, spec.msgs, spec.nonce, privKey1)
-			err := s.App.OnionKeeper.ExecuteAnte(s.Ctx, tx)
+			err := s.App.OnionKeeper.ExecuteAnte(s.Ctx, tx, testRelayer)
 			if spec.expErr {
 				s.Require().Error(err)
 			} else {
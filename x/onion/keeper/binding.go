@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"onion/x/onion/types"
+)
+
+// GetPacketBinding returns the packet binding of an onion tx, set through
+// ExtensionOptionPacketBinding, or nil if it has none.
+func GetPacketBinding(tx sdk.Tx) *types.ExtensionOptionPacketBinding {
+	extTx, ok := tx.(ante.HasExtensionOptionsTx)
+	if !ok {
+		return nil
+	}
+	for _, option := range extTx.GetExtensionOptions() {
+		if binding, ok := option.GetCachedValue().(*types.ExtensionOptionPacketBinding); ok {
+			return binding
+		}
+	}
+	return nil
+}
+
+// HasReceivedAmount reports whether the amount placeholders of an onion tx
+// are filled in with the funds the packet delivered, see
+// ExtensionOptionReceivedAmount.
+func HasReceivedAmount(tx sdk.Tx) bool {
+	extTx, ok := tx.(ante.HasExtensionOptionsTx)
+	if !ok {
+		return false
+	}
+	for _, option := range extTx.GetExtensionOptions() {
+		if _, ok := option.GetCachedValue().(*types.ExtensionOptionReceivedAmount); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// fillReceivedAmount replaces the amount placeholders in the messages of tx
+// with the funds exposed on ctx when tx opts in. The decoded messages are
+// changed in place, the signed tx bytes are not.
+func fillReceivedAmount(ctx sdk.Context, tx sdk.Tx) error {
+	if !HasReceivedAmount(tx) {
+		return nil
+	}
+	funds, ok := types.ReceivedFundsFromContext(ctx)
+	if !ok {
+		return errorsmod.Wrap(types.ErrReceivedAmount, "onion tx is not carried by an ICS-20 packet")
+	}
+	count, err := types.FillReceivedAmount(tx.GetMsgs(), funds.Coin)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrReceivedAmount, err.Error())
+	}
+	if count == 0 {
+		return errorsmod.Wrapf(types.ErrReceivedAmount, "no %s placeholder in the tx messages", types.ReceivedAmountDenom)
+	}
+	return nil
+}
+
+// CheckPacketBinding checks that tx is carried by a packet whose funds are
+// exposed on ctx, see types.WithReceivedFunds, and that its receiver is the
+// only signer when the packet binding of tx requires it.
+func CheckPacketBinding(ctx sdk.Context, tx sdk.Tx) error {
+	binding := GetPacketBinding(tx)
+	if binding == nil {
+		return nil
+	}
+	funds, ok := types.ReceivedFundsFromContext(ctx)
+	if !ok {
+		return errorsmod.Wrap(types.ErrPacketBinding, "onion tx is not carried by an ICS-20 packet")
+	}
+	if !binding.Receiver {
+		return nil
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid tx type")
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return err
+	}
+	receiver, err := sdk.AccAddressFromBech32(funds.Receiver)
+	if err != nil {
+		return err
+	}
+	if len(signers) != 1 || !bytes.Equal(signers[0], receiver) {
+		return errorsmod.Wrapf(types.ErrPacketBinding, "onion tx must be signed by the packet receiver %s alone", funds.Receiver)
+	}
+	return nil
+}
+
+// spendLimit returns the funds the packet carrying tx delivered when the
+// packet binding of tx limits its spending to them.
+func spendLimit(ctx sdk.Context, tx sdk.Tx) (types.ReceivedFunds, bool) {
+	binding := GetPacketBinding(tx)
+	if binding == nil || !binding.SpendLimit {
+		return types.ReceivedFunds{}, false
+	}
+	return types.ReceivedFundsFromContext(ctx)
+}
+
+// signerBalances returns the summed balances of the signers of tx.
+func (k Keeper) signerBalances(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid tx type")
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return nil, err
+	}
+	balances := sdk.NewCoins()
+	for _, signer := range signers {
+		balances = balances.Add(k.bankKeeper.GetAllBalances(ctx, signer)...)
+	}
+	return balances, nil
+}
+
+// checkSpendLimit checks that the signers of tx, whose balances were before
+// when the tx started, spent no more than the coin of funds and nothing of
+// any other denom.
+func (k Keeper) checkSpendLimit(ctx sdk.Context, tx sdk.Tx, funds types.ReceivedFunds, before sdk.Coins) error {
+	after, err := k.signerBalances(ctx, tx)
+	if err != nil {
+		return err
+	}
+	for _, coin := range before {
+		spent := coin.Amount.Sub(after.AmountOf(coin.Denom))
+		allowed := sdkmath.ZeroInt()
+		if coin.Denom == funds.Coin.Denom {
+			allowed = funds.Coin.Amount
+		}
+		if spent.GT(allowed) {
+			return errorsmod.Wrapf(types.ErrSpendLimit, "spent %s%s, packet delivered %s", spent, coin.Denom, funds.Coin)
+		}
+	}
+	return nil
+}
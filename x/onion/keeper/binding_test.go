@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"onion/x/onion/keeper"
+	"onion/x/onion/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// withPacketBinding adds binding to the onion tx being built.
+func (s *KeeperTestSuite) withPacketBinding(binding types.ExtensionOptionPacketBinding, edit func(client.TxBuilder)) func(client.TxBuilder) {
+	return func(b client.TxBuilder) {
+		option, err := codectypes.NewAnyWithValue(&binding)
+		s.Require().NoError(err)
+		b.(client.ExtendedTxBuilder).SetExtensionOptions(option)
+		if edit != nil {
+			edit(b)
+		}
+	}
+}
+
+func (s *KeeperTestSuite) TestPacketBinding() {
+	receiverKey := secp256k1.GenPrivKeyFromSecret([]byte("receiver"))
+	s.Require().Equal(testReceiver, sdk.AccAddress(receiverKey.PubKey().Address()))
+	otherKey := secp256k1.GenPrivKeyFromSecret([]byte("test1"))
+	recipient := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test2")).PubKey().Address())
+
+	funds, err := types.NewReceivedFunds(testPacket, testPacketData(""))
+	s.Require().NoError(err)
+	voucher := funds.Coin
+	s.Require().Equal("100", voucher.Amount.String())
+
+	specs := map[string]struct {
+		privKey  *secp256k1.PrivKey
+		binding  types.ExtensionOptionPacketBinding
+		send     sdk.Coins
+		edit     func(client.TxBuilder)
+		expErr   error
+		expStage types.ExecutionStage
+	}{
+		"receiver signs": {
+			privKey: receiverKey,
+			binding: types.ExtensionOptionPacketBinding{Receiver: true},
+			send:    sdk.NewCoins(sdk.NewInt64Coin("test", 500)),
+		},
+		"other signer": {
+			privKey:  otherKey,
+			binding:  types.ExtensionOptionPacketBinding{Receiver: true},
+			send:     sdk.NewCoins(sdk.NewInt64Coin("test", 500)),
+			expErr:   types.ErrAnteFailed,
+			expStage: types.EXECUTION_STAGE_ANTE,
+		},
+		"other signer without receiver binding": {
+			privKey: otherKey,
+			binding: types.ExtensionOptionPacketBinding{},
+			send:    sdk.NewCoins(sdk.NewInt64Coin("test", 500)),
+		},
+		"spend delivered funds": {
+			privKey: receiverKey,
+			binding: types.ExtensionOptionPacketBinding{Receiver: true, SpendLimit: true},
+			send:    sdk.NewCoins(voucher),
+		},
+		"spend more than delivered": {
+			privKey:  receiverKey,
+			binding:  types.ExtensionOptionPacketBinding{SpendLimit: true},
+			send:     sdk.NewCoins(voucher.AddAmount(voucher.Amount)),
+			expErr:   types.ErrExecuteFailed,
+			expStage: types.EXECUTION_STAGE_EXECUTE,
+		},
+		"spend other denom": {
+			privKey:  receiverKey,
+			binding:  types.ExtensionOptionPacketBinding{SpendLimit: true},
+			send:     sdk.NewCoins(sdk.NewInt64Coin("test", 1)),
+			expErr:   types.ErrExecuteFailed,
+			expStage: types.EXECUTION_STAGE_EXECUTE,
+		},
+		"fee counts against the limit": {
+			privKey: receiverKey,
+			binding: types.ExtensionOptionPacketBinding{SpendLimit: true},
+			send:    sdk.NewCoins(voucher),
+			edit: func(b client.TxBuilder) {
+				b.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("test", 10)))
+			},
+			expErr:   types.ErrExecuteFailed,
+			expStage: types.EXECUTION_STAGE_EXECUTE,
+		},
+	}
+	for name, spec := range specs {
+		s.Run(name, func() {
+			s.SetupTest()
+			addr := sdk.AccAddress(spec.privKey.PubKey().Address())
+			// the voucher stands for the funds credited by the transfer
+			s.fund(testReceiver, sdk.NewCoins(voucher))
+			s.fund(addr, sdk.NewCoins(voucher, sdk.NewInt64Coin("test", 1000)))
+
+			msg := &banktypes.MsgSend{FromAddress: addr.String(), ToAddress: recipient.String(), Amount: spec.send}
+			tx := newTxWith(s.T(), s.App.TxConfig(), addr, s.Ctx.ChainID(), types.AccountNumber, []sdk.Msg{msg}, 0, spec.privKey, s.withPacketBinding(spec.binding, spec.edit))
+			txBytes, err := s.App.TxConfig().TxEncoder()(tx)
+			s.Require().NoError(err)
+
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+			err = s.App.OnionKeeper.HandleTransferHook(s.Ctx, testPacket, testRelayer, testPacketData(s.onionMemo(txBytes)), s.App.TxConfig())
+			event := s.onionExecutionEvent(s.Ctx)
+			s.Require().Equal(&funds, event.Received)
+			if spec.expErr != nil {
+				s.Require().ErrorIs(err, spec.expErr)
+				s.Require().Equal(spec.expStage, event.FailedStage)
+				s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, recipient).IsZero())
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(spec.send, s.App.BankKeeper.GetAllBalances(s.Ctx, recipient))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestPacketBindingWithoutPacket() {
+	privKey := secp256k1.GenPrivKeyFromSecret([]byte("receiver"))
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test2")).PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 100))
+
+	s.SetupTest()
+	s.fund(addr, coins)
+
+	msg := &banktypes.MsgSend{FromAddress: addr.String(), ToAddress: recipient.String(), Amount: coins}
+	tx := newTxWith(s.T(), s.App.TxConfig(), addr, s.Ctx.ChainID(), types.AccountNumber, []sdk.Msg{msg}, 0, privKey,
+		s.withPacketBinding(types.ExtensionOptionPacketBinding{Receiver: true}, nil))
+	txBytes, err := s.App.TxConfig().TxEncoder()(tx)
+	s.Require().NoError(err)
+
+	_, err = keeper.NewMsgServerImpl(s.App.OnionKeeper).ExecuteOnion(s.Ctx, types.NewMsgExecuteOnion(testRelayer.String(), txBytes))
+	s.Require().ErrorIs(err, types.ErrAnteFailed)
+	s.Require().ErrorContains(err, "not carried by an ICS-20 packet")
+	s.Require().Equal(coins, s.App.BankKeeper.GetAllBalances(s.Ctx, addr))
+}
@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	"bytes"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	txsigning "cosmossdk.io/x/tx/signing"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"onion/x/onion/types"
+)
+
+// OnionTxCheck names a check an onion tx goes through before its messages
+// run, see CheckOnionTx.
+type OnionTxCheck string
+
+const (
+	OnionTxCheckTimeout        OnionTxCheck = "timeout"
+	OnionTxCheckMemoDeadline   OnionTxCheck = "memo_deadline"
+	OnionTxCheckGasLimit       OnionTxCheck = "gas_limit"
+	OnionTxCheckMsgFilter      OnionTxCheck = "msg_filter"
+	OnionTxCheckPacketBinding  OnionTxCheck = "packet_binding"
+	OnionTxCheckReceivedAmount OnionTxCheck = "received_amount"
+	OnionTxCheckValidateBasic  OnionTxCheck = "validate_basic"
+	OnionTxCheckFee            OnionTxCheck = "fee"
+	OnionTxCheckPubKey         OnionTxCheck = "pubkey"
+	OnionTxCheckSigCount       OnionTxCheck = "sig_count"
+	OnionTxCheckSequence       OnionTxCheck = "sequence"
+	OnionTxCheckSignature      OnionTxCheck = "signature"
+)
+
+// OnionTxChecks lists the checks of CheckOnionTx in the order they run. The
+// checks from OnionTxCheckValidateBasic on cover the signed tx and are not
+// repeated for queued txs that passed them before.
+var OnionTxChecks = []OnionTxCheck{
+	OnionTxCheckTimeout,
+	OnionTxCheckMemoDeadline,
+	OnionTxCheckGasLimit,
+	OnionTxCheckMsgFilter,
+	OnionTxCheckPacketBinding,
+	OnionTxCheckReceivedAmount,
+	OnionTxCheckValidateBasic,
+	OnionTxCheckFee,
+	OnionTxCheckPubKey,
+	OnionTxCheckSigCount,
+	OnionTxCheckSequence,
+	OnionTxCheckSignature,
+}
+
+// OnionTxState is the state the checks of an onion tx read. The keeper
+// provides it for the state of a context, clients implement it with queries
+// against a node.
+type OnionTxState interface {
+	Params() (types.Params, error)
+	// TxSigLimit is the TxSigLimit param of the auth module.
+	TxSigLimit() (uint64, error)
+	// Account returns the account of addr, or nil if it does not exist.
+	Account(addr sdk.AccAddress) (sdk.AccountI, error)
+	// Sequence returns the onion sequence of addr, zero if it has none.
+	Sequence(addr sdk.AccAddress) (uint64, error)
+	UnorderedNonces(addr sdk.AccAddress) (types.UnorderedNonces, error)
+}
+
+// TxState returns the OnionTxState of the keeper at ctx.
+func (k Keeper) TxState(ctx sdk.Context) OnionTxState {
+	return keeperTxState{k: k, ctx: ctx}
+}
+
+type keeperTxState struct {
+	k   Keeper
+	ctx sdk.Context
+}
+
+func (s keeperTxState) Params() (types.Params, error) {
+	return s.k.GetParams(s.ctx), nil
+}
+
+func (s keeperTxState) TxSigLimit() (uint64, error) {
+	return s.k.accountKeeper.GetParams(s.ctx).TxSigLimit, nil
+}
+
+func (s keeperTxState) Account(addr sdk.AccAddress) (sdk.AccountI, error) {
+	return s.k.accountKeeper.GetAccount(s.ctx, addr), nil
+}
+
+func (s keeperTxState) Sequence(addr sdk.AccAddress) (uint64, error) {
+	seq, err := s.k.GetSequence(s.ctx, addr.String())
+	if err != nil {
+		return 0, err
+	}
+	return seq.Sequence, nil
+}
+
+func (s keeperTxState) UnorderedNonces(addr sdk.AccAddress) (types.UnorderedNonces, error) {
+	return s.k.GetUnorderedNonces(s.ctx, addr.String())
+}
+
+// CheckOnionTx runs the checks of OnionTxChecks in order against tx and
+// returns the first one that fails along with its error. Nothing is written
+// to state, the messages of tx are changed in place when the received
+// amount is filled in. The block height, time and chain id are taken from
+// ctx, the funds of the packet carrying tx from types.ReceivedFundsFromContext.
+// Accounts that do not exist or have no pubkey yet are checked with the
+// pubkeys of tx, as ExecuteAnte sets them. The balance of the fee payer is
+// not checked. With anteDone, the checks of the signed tx are skipped.
+func CheckOnionTx(ctx sdk.Context, state OnionTxState, signModeHandler *txsigning.HandlerMap, tx sdk.Tx, memoDeadline *time.Time, anteDone bool) (OnionTxCheck, error) {
+	if err := ValidateTxTimeout(ctx, tx); err != nil {
+		return OnionTxCheckTimeout, err
+	}
+	if memoDeadline != nil {
+		if err := ValidateDeadline(ctx, *memoDeadline); err != nil {
+			return OnionTxCheckMemoDeadline, err
+		}
+	}
+
+	// the gas meter of ctx is limited by the declared gas, it is checked
+	// before any state is read
+	if err := ValidateGasLimit(tx); err != nil {
+		return OnionTxCheckGasLimit, err
+	}
+
+	params, err := state.Params()
+	if err != nil {
+		return OnionTxCheckMsgFilter, err
+	}
+	if err := params.CheckMsgTypes(tx.GetMsgs()); err != nil {
+		return OnionTxCheckMsgFilter, err
+	}
+	if err := CheckPacketBinding(ctx, tx); err != nil {
+		return OnionTxCheckPacketBinding, err
+	}
+	// the signatures are verified over the tx bytes, the placeholders they
+	// cover are not changed by filling in the decoded messages
+	if err := fillReceivedAmount(ctx, tx); err != nil {
+		return OnionTxCheckReceivedAmount, err
+	}
+	if anteDone {
+		return "", nil
+	}
+
+	if validateBasic, ok := tx.(sdk.HasValidateBasic); ok {
+		if err := validateBasic.ValidateBasic(); err != nil {
+			return OnionTxCheckValidateBasic, err
+		}
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return OnionTxCheckFee, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	if err := params.CheckTxFee(feeTx); err != nil {
+		return OnionTxCheckFee, err
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return OnionTxCheckPubKey, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid tx type")
+	}
+	pubKeys, err := sigTx.GetPubKeys()
+	if err != nil {
+		return OnionTxCheckPubKey, err
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return OnionTxCheckPubKey, err
+	}
+	if len(pubKeys) != len(signers) {
+		return OnionTxCheckPubKey, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey,
+			"invalid number of pubkeys; expected: %d, got %d", len(signers), len(pubKeys))
+	}
+	for i, pk := range pubKeys {
+		if pk != nil && !bytes.Equal(pk.Address(), signers[i]) {
+			return OnionTxCheckPubKey, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey,
+				"pubKey does not match signer address %s with signer index: %d", sdk.AccAddress(signers[i]), i)
+		}
+	}
+	// a pubkey already set on the account of a signer is kept, see
+	// ExecuteAnte
+	signerPubKeys := make([]cryptotypes.PubKey, len(signers))
+	for i, signer := range signers {
+		acc, err := state.Account(signer)
+		if err != nil {
+			return OnionTxCheckPubKey, err
+		}
+		if acc != nil {
+			signerPubKeys[i] = acc.GetPubKey()
+		}
+		if signerPubKeys[i] == nil {
+			signerPubKeys[i] = pubKeys[i]
+		}
+		if signerPubKeys[i] == nil {
+			return OnionTxCheckPubKey, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
+		}
+	}
+
+	sigLimit, err := state.TxSigLimit()
+	if err != nil {
+		return OnionTxCheckSigCount, err
+	}
+	sigCount := 0
+	for _, pk := range pubKeys {
+		sigCount += CountSubKeys(pk)
+		if uint64(sigCount) > sigLimit {
+			return OnionTxCheckSigCount, errorsmod.Wrapf(sdkerrors.ErrTooManySignatures,
+				"signatures: %d, limit: %d", sigCount, sigLimit)
+		}
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return OnionTxCheckSigCount, err
+	}
+	if len(sigs) != len(signers) {
+		return OnionTxCheckSigCount, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signers), len(sigs))
+	}
+
+	// the signers either follow their strictly increasing onion sequence or,
+	// when the tx opts in, use nonces that are accepted in any order
+	unordered := IsUnorderedTx(tx)
+	for i, sig := range sigs {
+		signer := sdk.AccAddress(signers[i])
+		if unordered {
+			nonces, err := state.UnorderedNonces(signer)
+			if err != nil {
+				return OnionTxCheckSequence, err
+			}
+			if err := nonces.Check(sig.Sequence); err != nil {
+				return OnionTxCheckSequence, err
+			}
+			continue
+		}
+		onionSeq, err := state.Sequence(signer)
+		if err != nil {
+			return OnionTxCheckSequence, err
+		}
+		if sig.Sequence != onionSeq {
+			return OnionTxCheckSequence, errorsmod.Wrapf(
+				sdkerrors.ErrWrongSequence,
+				"onion sequence mismatch, expected %d, got %d", onionSeq, sig.Sequence,
+			)
+		}
+	}
+
+	for i, sig := range sigs {
+		if err := verifySignature(ctx, signModeHandler, tx, sdk.AccAddress(signers[i]).String(), signerPubKeys[i], sig); err != nil {
+			return OnionTxCheckSignature, err
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"time"
+
+	"onion/x/onion/keeper"
+	"onion/x/onion/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+func (s *KeeperTestSuite) TestCheckOnionTx() {
+	privKey1 := secp256k1.GenPrivKeyFromSecret([]byte("test1"))
+	addr1 := sdk.AccAddress(privKey1.PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test2")).PubKey().Address())
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: addr1.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	unordered := func(b client.TxBuilder) {
+		option, err := codectypes.NewAnyWithValue(&types.ExtensionOptionUnorderedNonce{})
+		s.Require().NoError(err)
+		b.(client.ExtendedTxBuilder).SetExtensionOptions(option)
+	}
+	past := time.Unix(1, 0)
+
+	specs := map[string]struct {
+		accNum       uint64
+		nonce        uint64
+		edit         func(client.TxBuilder)
+		memoDeadline *time.Time
+		usedNonce    bool
+		anteDone     bool
+		edited       func(*txtypes.TxRaw, *txtypes.AuthInfo)
+		expCheck     keeper.OnionTxCheck
+	}{
+		"valid": {
+			accNum: types.AccountNumber,
+		},
+		"memo deadline passed": {
+			accNum:       types.AccountNumber,
+			memoDeadline: &past,
+			expCheck:     keeper.OnionTxCheckMemoDeadline,
+		},
+		"zero gas limit": {
+			accNum:   types.AccountNumber,
+			edit:     func(b client.TxBuilder) { b.SetGasLimit(0) },
+			expCheck: keeper.OnionTxCheckGasLimit,
+		},
+		"packet binding without packet": {
+			accNum:   types.AccountNumber,
+			edit:     s.withPacketBinding(types.ExtensionOptionPacketBinding{Receiver: true}, nil),
+			expCheck: keeper.OnionTxCheckPacketBinding,
+		},
+		"wrong sequence": {
+			accNum:   types.AccountNumber,
+			nonce:    1,
+			expCheck: keeper.OnionTxCheckSequence,
+		},
+		"used unordered nonce": {
+			accNum:    types.AccountNumber,
+			edit:      unordered,
+			usedNonce: true,
+			expCheck:  keeper.OnionTxCheckSequence,
+		},
+		"more pubkeys than signers": {
+			accNum: types.AccountNumber,
+			edited: func(_ *txtypes.TxRaw, authInfo *txtypes.AuthInfo) {
+				authInfo.SignerInfos = append(authInfo.SignerInfos, authInfo.SignerInfos[0])
+			},
+			expCheck: keeper.OnionTxCheckPubKey,
+		},
+		"wrong account number": {
+			accNum:   1,
+			expCheck: keeper.OnionTxCheckSignature,
+		},
+		"signed checks skipped with ante done": {
+			accNum:   1,
+			nonce:    1,
+			anteDone: true,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockTime(past.Add(time.Hour))
+			if spec.usedNonce {
+				nonces := types.NewUnorderedNonces(addr1.String())
+				s.Require().NoError(nonces.Use(0))
+				s.Require().NoError(s.App.OnionKeeper.SetUnorderedNonces(s.Ctx, nonces))
+			}
+
+			var tx sdk.Tx = newTxWith(s.T(), s.App.TxConfig(), addr1, s.Ctx.ChainID(), spec.accNum, []sdk.Msg{msgSend}, spec.nonce, privKey1, spec.edit)
+			if spec.edited != nil {
+				tx = s.editSignedTx(tx, spec.edited)
+			}
+			check, err := keeper.CheckOnionTx(s.Ctx, s.App.OnionKeeper.TxState(s.Ctx), s.App.OnionKeeper.SignModeHandler, tx, spec.memoDeadline, spec.anteDone)
+			s.Require().Equal(spec.expCheck, check)
+			if spec.expCheck != "" {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+
+			// the checks write no state, the signer account is only created
+			// by ExecuteAnte
+			s.Require().Nil(s.App.AccountKeeper.GetAccount(s.Ctx, addr1))
+			seq, err := s.App.OnionKeeper.GetSequence(s.Ctx, addr1.String())
+			s.Require().NoError(err)
+			s.Require().Zero(seq.Sequence)
+		})
+	}
+}
+
+// editSignedTx re-encodes the signed tx after applying edit to its raw form
+// and auth info, e.g. to add signer infos the tx builder would not accept.
+func (s *KeeperTestSuite) editSignedTx(tx sdk.Tx, edit func(*txtypes.TxRaw, *txtypes.AuthInfo)) sdk.Tx {
+	txBytes, err := s.App.TxConfig().TxEncoder()(tx)
+	s.Require().NoError(err)
+
+	var raw txtypes.TxRaw
+	s.Require().NoError(proto.Unmarshal(txBytes, &raw))
+	var authInfo txtypes.AuthInfo
+	s.Require().NoError(proto.Unmarshal(raw.AuthInfoBytes, &authInfo))
+	edit(&raw, &authInfo)
+	raw.AuthInfoBytes, err = proto.Marshal(&authInfo)
+	s.Require().NoError(err)
+	txBytes, err = proto.Marshal(&raw)
+	s.Require().NoError(err)
+
+	tx, err = s.App.TxConfig().TxDecoder()(txBytes)
+	s.Require().NoError(err)
+	return tx
+}
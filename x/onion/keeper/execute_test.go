@@ -8,6 +8,7 @@ import (
 
 	txsigning "cosmossdk.io/x/tx/signing"
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -103,8 +104,18 @@ func (s *KeeperTestSuite) TestExecuteTxMsgs() {
 }
 
 func newTx(t *testing.T, cfg client.TxConfig, addr sdk.AccAddress, chainId string, accountNumber uint64, msgs []sdk.Msg, nonce uint64, privKey *secp256k1.PrivKey) signing.Tx {
+	return newTxWith(t, cfg, addr, chainId, accountNumber, msgs, nonce, privKey, nil)
+}
+
+// newTxWith builds a signed tx like newTx, edit is applied to the builder
+// before signing to set e.g. the gas limit, fee or fee granter.
+func newTxWith(t *testing.T, cfg client.TxConfig, addr sdk.AccAddress, chainId string, accountNumber uint64, msgs []sdk.Msg, nonce uint64, privKey *secp256k1.PrivKey, edit func(client.TxBuilder)) signing.Tx {
 	builder := cfg.NewTxBuilder()
 	builder.SetMsgs(msgs...)
+	builder.SetGasLimit(flags.DefaultGasLimit)
+	if edit != nil {
+		edit(builder)
+	}
 	if len(msgs) > 0 {
 		pubKey := privKey.PubKey()
 		signModeHandler := cfg.SignModeHandler()
@@ -0,0 +1,8 @@
+package keeper
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// RunWithGasMeter exposes runWithGasMeter to the tests.
+func (k Keeper) RunWithGasMeter(ctx sdk.Context, fn func(sdk.Context) error) error {
+	return k.runWithGasMeter(ctx, fn)
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CheckTxFee verifies the fee of an onion tx against the min_gas_prices
+// param, see Params.CheckTxFee.
+func (k Keeper) CheckTxFee(ctx sdk.Context, feeTx sdk.FeeTx) error {
+	return k.GetParams(ctx).CheckTxFee(feeTx)
+}
+
+// DeductTxFee sends the fee of an onion tx to the relayer that delivered it.
+// The fee is paid by the fee granter if one is set, otherwise by the fee payer.
+func (k Keeper) DeductTxFee(ctx sdk.Context, feeTx sdk.FeeTx, relayer sdk.AccAddress) error {
+	fee := feeTx.GetFee()
+	if fee.IsZero() {
+		return nil
+	}
+	if !fee.IsValid() {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "invalid fee amount: %s", fee)
+	}
+
+	feePayer := feeTx.FeePayer()
+	feeGranter := feeTx.FeeGranter()
+	deductFeesFrom := feePayer
+
+	// if feegranter set deduct fee from feegranter account.
+	// this works with only when feegrant enabled.
+	if feeGranter != nil {
+		feeGranterAddr := sdk.AccAddress(feeGranter)
+
+		if k.feegrantKeeper == nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("fee grants are not enabled")
+		} else if !bytes.Equal(feeGranterAddr, feePayer) {
+			err := k.feegrantKeeper.UseGrantedFees(ctx, feeGranterAddr, feePayer, fee, feeTx.GetMsgs())
+			if err != nil {
+				return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranterAddr, sdk.AccAddress(feePayer))
+			}
+		}
+
+		deductFeesFrom = feeGranterAddr
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, deductFeesFrom, relayer, fee); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInsufficientFunds, err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeTx,
+		sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+		sdk.NewAttribute(sdk.AttributeKeyFeePayer, sdk.AccAddress(deductFeesFrom).String()),
+	))
+	return nil
+}
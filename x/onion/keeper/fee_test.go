@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"onion/x/onion/types"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func (s *KeeperTestSuite) TestExecuteAnteFees() {
+	privKey1 := secp256k1.GenPrivKeyFromSecret([]byte("test1"))
+	addr1 := sdk.AccAddress(privKey1.PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test2")).PubKey().Address())
+	granter := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("granter")).PubKey().Address())
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: addr1.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+
+	fundAccount := func(addr sdk.AccAddress, coins sdk.Coins) {
+		err := s.App.BankKeeper.MintCoins(s.Ctx, minttypes.ModuleName, coins)
+		s.Require().NoError(err)
+		err = s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, minttypes.ModuleName, addr, coins)
+		s.Require().NoError(err)
+	}
+
+	specs := map[string]struct {
+		fee          sdk.Coins
+		feeGranter   sdk.AccAddress
+		grant        bool
+		minGasPrices sdk.DecCoins
+		expErr       error
+		expPayer     sdk.AccAddress
+	}{
+		"no fee": {},
+		"fee paid by signer": {
+			fee:      sdk.NewCoins(sdk.NewInt64Coin("test", 50)),
+			expPayer: addr1,
+		},
+		"fee paid by fee granter": {
+			fee:        sdk.NewCoins(sdk.NewInt64Coin("test", 50)),
+			feeGranter: granter,
+			grant:      true,
+			expPayer:   granter,
+		},
+		"fee granter without grant": {
+			fee:        sdk.NewCoins(sdk.NewInt64Coin("test", 50)),
+			feeGranter: granter,
+			expErr:     sdkerrors.ErrNotFound,
+		},
+		"insufficient funds": {
+			fee:    sdk.NewCoins(sdk.NewInt64Coin("test", 1000)),
+			expErr: sdkerrors.ErrInsufficientFunds,
+		},
+		"min gas price met": {
+			fee:          sdk.NewCoins(sdk.NewInt64Coin("test", 20)),
+			minGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("test", sdkmath.LegacyNewDecWithPrec(1, 4))),
+			expPayer:     addr1,
+		},
+		"min gas price not met": {
+			fee:          sdk.NewCoins(sdk.NewInt64Coin("test", 19)),
+			minGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("test", sdkmath.LegacyNewDecWithPrec(1, 4))),
+			expErr:       sdkerrors.ErrInsufficientFee,
+		},
+		"fee denom not accepted": {
+			fee:          sdk.NewCoins(sdk.NewInt64Coin("other", 20)),
+			minGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec("test", sdkmath.LegacyNewDecWithPrec(1, 4))),
+			expErr:       sdkerrors.ErrInvalidCoins,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			s.SetupTest()
+			fundAccount(addr1, sdk.NewCoins(sdk.NewInt64Coin("test", 500), sdk.NewInt64Coin("other", 500)))
+			fundAccount(granter, sdk.NewCoins(sdk.NewInt64Coin("test", 500)))
+
+			params := types.DefaultParams()
+			params.MinGasPrices = spec.minGasPrices
+			s.Require().NoError(s.App.OnionKeeper.SetParams(s.Ctx, params))
+
+			if spec.grant {
+				err := s.App.FeeGrantKeeper.GrantAllowance(s.Ctx, granter, addr1, &feegrant.BasicAllowance{})
+				s.Require().NoError(err)
+			}
+
+			// gas limit of 200_000
+			tx := newTxWith(s.T(), s.App.TxConfig(), addr1, s.Ctx.ChainID(), types.AccountNumber, []sdk.Msg{msgSend}, 0, privKey1, func(b client.TxBuilder) {
+				b.SetFeeAmount(spec.fee)
+				b.SetFeeGranter(spec.feeGranter)
+			})
+			payerBalance := s.App.BankKeeper.GetAllBalances(s.Ctx, spec.expPayer)
+
+			err := s.App.OnionKeeper.ExecuteAnte(s.Ctx, tx, testRelayer)
+			if spec.expErr != nil {
+				s.Require().ErrorIs(err, spec.expErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(spec.fee.String(), s.App.BankKeeper.GetAllBalances(s.Ctx, testRelayer).String())
+			if spec.expPayer != nil {
+				s.Require().Equal(payerBalance.Sub(spec.fee...).String(), s.App.BankKeeper.GetAllBalances(s.Ctx, spec.expPayer).String())
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestFeePaidOnExecutionFailure() {
+	privKey1 := secp256k1.GenPrivKeyFromSecret([]byte("test1"))
+	addr1 := sdk.AccAddress(privKey1.PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test2")).PubKey().Address())
+	fee := sdk.NewCoins(sdk.NewInt64Coin("test", 50))
+
+	s.SetupTest()
+	s.fund(addr1, sdk.NewCoins(sdk.NewInt64Coin("test", 500)))
+
+	// sends more than is left after the fee
+	msgSend := &banktypes.MsgSend{FromAddress: addr1.String(), ToAddress: addr2.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 500))}
+	tx := newTxWith(s.T(), s.App.TxConfig(), addr1, s.Ctx.ChainID(), types.AccountNumber, []sdk.Msg{msgSend}, 0, privKey1, func(b client.TxBuilder) {
+		b.SetFeeAmount(fee)
+	})
+	txBytes, err := s.App.TxConfig().TxEncoder()(tx)
+	s.Require().NoError(err)
+
+	err = s.App.OnionKeeper.HandleTransferHook(s.Ctx, testPacket, testRelayer, testPacketData(s.onionMemo(txBytes)), s.App.TxConfig())
+	s.Require().ErrorIs(err, types.ErrExecuteFailed)
+
+	s.Require().Equal(fee, s.App.BankKeeper.GetAllBalances(s.Ctx, testRelayer))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("test", 450)), s.App.BankKeeper.GetAllBalances(s.Ctx, addr1))
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, addr2).IsZero())
+	seq, err := s.App.OnionKeeper.GetSequence(s.Ctx, addr1.String())
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), seq.Sequence)
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"runtime/debug"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GasLimit returns the gas limit an onion tx is executed with, the smaller
+// of the limit declared in its fee and the max_gas param.
+func (k Keeper) GasLimit(ctx sdk.Context, tx sdk.Tx) uint64 {
+	gasLimit := k.GetParams(ctx).MaxGas
+	if feeTx, ok := tx.(sdk.FeeTx); ok && feeTx.GetGas() < gasLimit {
+		gasLimit = feeTx.GetGas()
+	}
+	return gasLimit
+}
+
+// ValidateGasLimit rejects onion txs declaring a zero gas limit, they would
+// run out of gas before any check is done.
+func ValidateGasLimit(tx sdk.Tx) error {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	if feeTx.GetGas() == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "onion tx gas limit must be positive")
+	}
+	return nil
+}
+
+// runWithGasMeter runs fn and turns an out of gas panic raised by the gas
+// meter of ctx into an error. Any other panic raised by the ante or message
+// handlers is logged and turned into an error as well, so it fails the onion
+// tx instead of the tx of the relayer.
+func (k Keeper) runWithGasMeter(ctx sdk.Context, fn func(sdk.Context) error) (err error) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		if oog, ok := r.(storetypes.ErrorOutOfGas); ok {
+			err = errorsmod.Wrapf(
+				sdkerrors.ErrOutOfGas,
+				"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
+				oog.Descriptor, ctx.GasMeter().Limit(), ctx.GasMeter().GasConsumed(),
+			)
+			return
+		}
+		k.Logger().Error("panic in onion tx", "panic", r, "stack", string(debug.Stack()))
+		err = errorsmod.Wrapf(sdkerrors.ErrPanic, "recovered: %v", r)
+	}()
+
+	return fn(ctx)
+}
@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"onion/x/onion/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (s *KeeperTestSuite) TestGenesisRoundTrip() {
+	privKey := secp256k1.GenPrivKeyFromSecret([]byte("test1"))
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test2")).PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 100))
+
+	s.SetupTest()
+	id := s.queueFailingTx(privKey, recipient, coins, nil)
+
+	genState := s.App.OnionKeeper.ExportGenesis(s.Ctx)
+	s.Require().NoError(genState.Validate())
+	s.Require().Len(genState.QueuedTxs, 1)
+	s.Require().Equal(id, genState.QueuedTxs[0].Id)
+	s.Require().Equal(id+1, genState.NextQueuedTxId)
+	s.Require().Len(genState.ExecutionReceipts, 1)
+
+	s.SetupTest()
+	s.App.OnionKeeper.InitGenesis(s.Ctx, *genState)
+	s.Require().Equal(genState, s.App.OnionKeeper.ExportGenesis(s.Ctx))
+
+	// the indexes are rebuilt
+	queuedRes, err := s.App.OnionKeeper.QueuedTxsBySigner(s.Ctx, &types.QueryQueuedTxsBySignerRequest{Signer: addr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(genState.QueuedTxs, queuedRes.QueuedTxs)
+	receiptsRes, err := s.App.OnionKeeper.ExecutionsBySigner(s.Ctx, &types.QueryExecutionsBySignerRequest{Signer: addr.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(genState.ExecutionReceipts, receiptsRes.Receipts)
+
+	// the ids continue after the imported ones
+	otherKey := secp256k1.GenPrivKeyFromSecret([]byte("test3"))
+	s.Require().Equal(id+1, s.queueFailingTx(otherKey, recipient, coins, nil))
+}
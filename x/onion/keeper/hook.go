@@ -1,31 +1,286 @@
 package keeper
 
 import (
-	"encoding/base64"
+	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"onion/x/onion/types"
 )
 
-func (k Keeper) HandleTransferHook(ctx sdk.Context, memo string, txEncodingConfig client.TxEncodingConfig) {
-	newRawTx, err := base64.StdEncoding.DecodeString(memo)
+// HandleTransferHook decodes the onion tx carried in the memo of the ICS-20
+// packet data and executes it, the funds the packet delivered are exposed
+// to the tx, see types.ReceivedFundsFromContext,
+// ExtensionOptionPacketBinding and ExtensionOptionReceivedAmount. Memos that
+// do not address the onion module are ignored. Once the ante checks pass,
+// the fee paid to the relayer and the onion sequence are written even when a
+// message fails, the state changes of the messages only when all of them
+// succeed. The returned error identifies the stage that failed.
+//
+// An EventOnionExecution is emitted and an execution receipt is stored on
+// ctx for every attempt. In atomic mode a failed tx turns into an error
+// acknowledgement, ibc-go then discards the events and state changes of ctx,
+// so neither the event nor the receipt of the failure remain, only the error
+// acknowledgement does. In best effort mode the recovery instruction of a
+// failed tx is run, see ExtensionOptionRecovery, and txs without one that
+// fail during execution are kept in the retry queue when they opt in with
+// ExtensionOptionRetry, see RetryOnion.
+func (k Keeper) HandleTransferHook(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress, data transfertypes.FungibleTokenPacketData, txEncodingConfig client.TxEncodingConfig) error {
+	params := k.GetParams(ctx)
+	parsed, found, err := types.ParseMemo(data.Memo, params.LegacyMemo)
+	if !found {
+		// the memo is meant for another middleware or is plain text
+		return nil
+	}
+
+	event := types.EventOnionExecution{
+		SourcePort:         packet.SourcePort,
+		SourceChannel:      packet.SourceChannel,
+		DestinationPort:    packet.DestinationPort,
+		DestinationChannel: packet.DestinationChannel,
+		PacketSequence:     packet.Sequence,
+	}
+	if funds, fundsErr := types.NewReceivedFunds(packet, data); fundsErr == nil {
+		event.Received = &funds
+		ctx = types.WithReceivedFunds(ctx, funds)
+	}
+
+	var results []sdk.Result
 	if err != nil {
-		return
+		event.FailedStage = types.EXECUTION_STAGE_DECODE
+		err = errorsmod.Wrap(types.ErrInvalidMemo, err.Error())
+	} else {
+		results, err = k.executeTx(ctx, &event, relayer, parsed, txEncodingConfig.TxDecoder(), false)
 	}
 
-	tx, err := txEncodingConfig.TxDecoder()(newRawTx)
+	// the funds of a failed tx are moved as its recovery instruction says,
+	// otherwise a tx with valid signatures that failed during execution can
+	// be retried while its intent may still hold. In atomic mode the transfer
+	// is reverted instead.
+	recovered := false
+	if err != nil && params.ExecutionMode == types.EXECUTION_MODE_BEST_EFFORT {
+		anteDone := event.FailedStage == types.EXECUTION_STAGE_EXECUTE
+		recovered = k.recoverFunds(ctx, packet, data, parsed.TxBytes, txEncodingConfig.TxDecoder(), anteDone)
+	}
+	if err != nil && !recovered && event.FailedStage == types.EXECUTION_STAGE_EXECUTE &&
+		params.RetryQueueBlocks > 0 && params.ExecutionMode == types.EXECUTION_MODE_BEST_EFFORT {
+		id, queueErr := k.queueTx(ctx, event, parsed, txEncodingConfig.TxDecoder(), params.RetryQueueBlocks, err)
+		if queueErr != nil {
+			k.Logger().Error("failed to queue onion tx for retries", "error", queueErr)
+		} else {
+			event.QueuedId = id
+		}
+	}
+	k.emitExecutionEvent(ctx, &event, err)
+	if params.ReceiptRetentionBlocks > 0 {
+		if setErr := k.SetExecutionReceipt(ctx, newExecutionReceipt(ctx, event, results)); setErr != nil {
+			k.Logger().Error("failed to store onion execution receipt", "error", setErr)
+		}
+	}
+	return err
+}
+
+// ExecuteOnion executes an onion tx submitted with MsgExecuteOnion. It goes
+// through the same checks as a tx carried by a packet, the submitter pays
+// the gas and receives the fee in place of a relayer. An EventOnionExecution
+// is emitted and returned with the outcome, no receipt is stored as there is
+// no packet to key it by. An error is only returned when the tx fails before
+// execution, the ante state of a tx whose messages fail is kept.
+func (k Keeper) ExecuteOnion(ctx sdk.Context, submitter sdk.AccAddress, txBytes []byte) (types.EventOnionExecution, []sdk.Result, error) {
+	event := types.EventOnionExecution{
+		Submitter: submitter.String(),
+	}
+	results, err := k.executeTx(ctx, &event, submitter, types.ParsedMemo{TxBytes: txBytes}, k.txDecoder, false)
+	k.emitExecutionEvent(ctx, &event, err)
+	if err != nil && event.FailedStage != types.EXECUTION_STAGE_EXECUTE {
+		return event, nil, err
+	}
+	return event, results, nil
+}
+
+// emitExecutionEvent records the outcome err in event and emits it.
+func (k Keeper) emitExecutionEvent(ctx sdk.Context, event *types.EventOnionExecution, err error) {
 	if err != nil {
-		return
+		event.Error = err.Error()
+		_, event.ErrorCode, _ = errorsmod.ABCIInfo(err, false)
+	} else {
+		event.Success = true
 	}
 
+	if emitErr := ctx.EventManager().EmitTypedEvent(event); emitErr != nil {
+		k.Logger().Error("failed to emit onion execution event", "error", emitErr)
+	}
+}
+
+// executeTx runs an onion tx with runTx. The ante state changes, the fee
+// paid to relayer and the used onion sequences, are written unless the tx
+// fails before or during the ante checks, so relayers are paid for txs
+// whose messages fail like they are for regular txs. The ante checks are
+// skipped with anteDone for txs that passed them before, see RetryOnion.
+func (k Keeper) executeTx(ctx sdk.Context, event *types.EventOnionExecution, relayer sdk.AccAddress, memo types.ParsedMemo, txDecoder sdk.TxDecoder, anteDone bool) ([]sdk.Result, error) {
 	cacheCtx, write := ctx.CacheContext()
-	err = k.ExecuteAnte(cacheCtx, tx)
+	results, err := k.runTx(cacheCtx, event, relayer, memo, txDecoder, anteDone)
+
+	// the onion tx runs on its own gas meter so it cannot use up the gas of
+	// the relayer's MsgRecvPacket beyond its limit, the gas used is charged
+	// to the packet afterwards
+	ctx.GasMeter().ConsumeGas(event.GasUsed, "onion tx")
+	if err != nil && event.FailedStage != types.EXECUTION_STAGE_EXECUTE {
+		return nil, err
+	}
+
+	write()
+	return results, err
+}
+
+// runTx decodes, checks and executes an onion tx on ctx and records the
+// outcome in event. The messages run on their own branch of ctx that is
+// only written when all of them succeed, ctx must be a branch of the state
+// that is discarded when the tx fails before the execution stage.
+func (k Keeper) runTx(ctx sdk.Context, event *types.EventOnionExecution, relayer sdk.AccAddress, memo types.ParsedMemo, txDecoder sdk.TxDecoder, anteDone bool) ([]sdk.Result, error) {
+	tx, err := txDecoder(memo.TxBytes)
 	if err != nil {
-		return
+		event.FailedStage = types.EXECUTION_STAGE_DECODE
+		return nil, errorsmod.Wrap(types.ErrTxDecode, err.Error())
+	}
+	if err := validateDecodedTx(tx); err != nil {
+		event.FailedStage = types.EXECUTION_STAGE_DECODE
+		return nil, errorsmod.Wrap(types.ErrTxDecode, err.Error())
+	}
+	setEventTxInfo(event, memo.TxBytes, tx)
+
+	funds, limited := spendLimit(ctx, tx)
+
+	event.GasLimit = k.GasLimit(ctx, tx)
+	gasMeter := storetypes.NewGasMeter(event.GasLimit)
+	defer func() {
+		event.GasUsed = gasMeter.GasConsumedToLimit()
+	}()
+
+	ctx = ctx.WithGasMeter(gasMeter)
+	// the spend limit covers the fee, the balances are taken before it is
+	// deducted
+	var (
+		balances sdk.Coins
+		check    OnionTxCheck
+	)
+	err = k.runWithGasMeter(ctx, func(ctx sdk.Context) (err error) {
+		if limited {
+			if balances, err = k.signerBalances(ctx, tx); err != nil {
+				return err
+			}
+		}
+		check, err = k.executeAnte(ctx, tx, memo.Deadline, relayer, anteDone)
+		return err
+	})
+	if err != nil {
+		event.FailedStage = types.EXECUTION_STAGE_ANTE
+		// expired txs fail with their own error code so senders can tell them
+		// apart from other ante failures
+		if check == OnionTxCheckTimeout || check == OnionTxCheckMemoDeadline {
+			return nil, err
+		}
+		return nil, errorsmod.Wrap(types.ErrAnteFailed, err.Error())
+	}
+
+	var results []sdk.Result
+	msgCtx, writeMsgs := ctx.CacheContext()
+	err = k.runWithGasMeter(msgCtx, func(ctx sdk.Context) (err error) {
+		if results, err = k.ExecuteTxMsgs(ctx, tx); err != nil || !limited {
+			return err
+		}
+		return k.checkSpendLimit(ctx, tx, funds, balances)
+	})
+	if err != nil {
+		event.FailedStage = types.EXECUTION_STAGE_EXECUTE
+		return nil, errorsmod.Wrap(types.ErrExecuteFailed, err.Error())
 	}
+	writeMsgs()
 
-	_, err = k.ExecuteTxMsgs(cacheCtx, tx)
-	if err == nil {
-		write()
+	// message handlers return their events in the result, re-emit them so
+	// they are propagated together with the cached state
+	for _, result := range results {
+		for _, ev := range result.Events {
+			ctx.EventManager().EmitEvent(sdk.Event(ev))
+		}
+	}
+	return results, nil
+}
+
+// protoTxProvider is implemented by the txs of the default tx decoder.
+type protoTxProvider interface {
+	GetProtoTx() *txtypes.Tx
+}
+
+// validateDecodedTx rejects txs the decoder accepts but whose fields cannot
+// be read without panicking, those without a fee and those whose signer
+// infos, signatures and signers do not line up one to one.
+func validateDecodedTx(tx sdk.Tx) error {
+	protoTx, isProtoTx := tx.(protoTxProvider)
+	if isProtoTx {
+		if authInfo := protoTx.GetProtoTx().AuthInfo; authInfo == nil || authInfo.Fee == nil {
+			return errorsmod.Wrap(sdkerrors.ErrTxDecode, "missing fee")
+		}
+	}
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil
+	}
+	pubKeys, err := sigTx.GetPubKeys()
+	if err != nil {
+		return err
+	}
+	if isProtoTx {
+		if sigs := len(protoTx.GetProtoTx().Signatures); sigs != len(pubKeys) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized,
+				"invalid number of signatures; signer infos: %d, signatures: %d", len(pubKeys), sigs)
+		}
 	}
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return err
+	}
+	if len(signers) != len(pubKeys) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized,
+			"invalid number of signer infos; signers: %d, signer infos: %d", len(signers), len(pubKeys))
+	}
+	return nil
+}
+
+// setEventTxInfo fills the tx related fields of an onion execution event.
+func setEventTxInfo(event *types.EventOnionExecution, txBytes []byte, tx sdk.Tx) {
+	event.TxHash = txHash(txBytes)
+
+	for _, msg := range tx.GetMsgs() {
+		event.MsgTypeUrls = append(event.MsgTypeUrls, sdk.MsgTypeURL(msg))
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return
+	}
+	if signers, err := sigTx.GetSigners(); err == nil {
+		for _, signer := range signers {
+			event.Signers = append(event.Signers, sdk.AccAddress(signer).String())
+		}
+	}
+	if sigs, err := sigTx.GetSignaturesV2(); err == nil {
+		for _, sig := range sigs {
+			event.OnionSequences = append(event.OnionSequences, sig.Sequence)
+		}
+	}
+}
+
+// txHash returns the hex encoded hash of the onion tx bytes.
+func txHash(txBytes []byte) string {
+	return fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash())
 }
@@ -2,15 +2,64 @@ package keeper_test
 
 import (
 	"encoding/base64"
+	"fmt"
 
 	"onion/x/onion/types"
 
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/gogoproto/proto"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 )
 
+var testPacket = channeltypes.Packet{
+	Sequence:           1,
+	SourcePort:         transfertypes.PortID,
+	SourceChannel:      "channel-0",
+	DestinationPort:    transfertypes.PortID,
+	DestinationChannel: "channel-1",
+}
+
+var testRelayer = sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("relayer")).PubKey().Address())
+
+var testReceiver = sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("receiver")).PubKey().Address())
+
+// testPacketData returns the data of a transfer of 100 test to testReceiver
+// over testPacket carrying memo.
+func testPacketData(memo string) transfertypes.FungibleTokenPacketData {
+	return transfertypes.NewFungibleTokenPacketData("test", "100", "sender", testReceiver.String(), memo)
+}
+
+// onionMemo wraps txBytes in the JSON memo envelope.
+func (s *KeeperTestSuite) onionMemo(txBytes []byte) string {
+	memo, err := types.NewMemo(txBytes)
+	s.Require().NoError(err)
+	return memo
+}
+
+// onionExecutionEvent returns the last EventOnionExecution emitted on ctx.
+func (s *KeeperTestSuite) onionExecutionEvent(ctx sdk.Context) *types.EventOnionExecution {
+	var event *types.EventOnionExecution
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type != proto.MessageName(&types.EventOnionExecution{}) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(ev))
+		s.Require().NoError(err)
+		event = msg.(*types.EventOnionExecution)
+	}
+	s.Require().NotNil(event)
+	return event
+}
+
 func (s *KeeperTestSuite) TestOnReceivePacketHook() {
 	privKey1 := secp256k1.GenPrivKeyFromSecret([]byte("test1"))
 	pubKey1 := privKey1.PubKey()
@@ -39,6 +88,7 @@ func (s *KeeperTestSuite) TestOnReceivePacketHook() {
 	specs := map[string]struct {
 		msgs               []sdk.Msg
 		expErr             bool
+		expSeq             uint64
 		expSenderBalance   sdk.Coins
 		expReceiverBalance sdk.Coins
 	}{
@@ -63,6 +113,7 @@ func (s *KeeperTestSuite) TestOnReceivePacketHook() {
 		"one execution failure in multiple messages": {
 			msgs:               []sdk.Msg{msgSend1, msgSend3},
 			expErr:             true,
+			expSeq:             1,
 			expSenderBalance:   sdk.Coins{},
 			expReceiverBalance: sdk.Coins{},
 		},
@@ -81,15 +132,19 @@ func (s *KeeperTestSuite) TestOnReceivePacketHook() {
 			txBytes, err := s.App.TxConfig().TxEncoder()(tx)
 			s.Require().NoError(err)
 
-			memo := base64.StdEncoding.EncodeToString(txBytes)
+			memo := s.onionMemo(txBytes)
 
-			s.App.OnionKeeper.HandleTransferHook(s.Ctx, memo, s.App.TxConfig())
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+			err = s.App.OnionKeeper.HandleTransferHook(s.Ctx, testPacket, testRelayer, testPacketData(memo), s.App.TxConfig())
 			if spec.expErr {
-				// Check sequence change
+				s.Require().Error(err)
+
+				// the sequence is used once the ante checks pass
 				seq, err := s.App.OnionKeeper.GetSequence(s.Ctx, addr1.String())
 				s.Require().NoError(err)
-				s.Require().Equal(seq.Sequence, uint64(0))
+				s.Require().Equal(spec.expSeq, seq.Sequence)
 			} else {
+				s.Require().NoError(err)
 				// Check sequence change
 				seq, err := s.App.OnionKeeper.GetSequence(s.Ctx, addr1.String())
 				s.Require().NoError(err)
@@ -100,6 +155,349 @@ func (s *KeeperTestSuite) TestOnReceivePacketHook() {
 				s.Require().Equal(senderBalance.String(), spec.expSenderBalance.String())
 				receiverBalance := s.App.BankKeeper.GetAllBalances(s.Ctx, addr2)
 				s.Require().Equal(receiverBalance.String(), spec.expReceiverBalance.String())
+
+				// Check events
+				event := s.onionExecutionEvent(s.Ctx)
+				s.Require().True(event.Success)
+				s.Require().Equal(types.EXECUTION_STAGE_UNSPECIFIED, event.FailedStage)
+				s.Require().Equal([]string{addr1.String()}, event.Signers)
+				s.Require().Equal([]uint64{0}, event.OnionSequences)
+				s.Require().Equal(testPacket.Sequence, event.PacketSequence)
+				s.Require().Len(event.MsgTypeUrls, len(spec.msgs))
+				s.Require().NotEmpty(event.TxHash)
+
+				transferEvents := 0
+				for _, ev := range s.Ctx.EventManager().Events() {
+					if ev.Type == banktypes.EventTypeTransfer {
+						transferEvents++
+					}
+				}
+				s.Require().Equal(len(spec.msgs), transferEvents)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestOnReceivePacketHookErrors() {
+	privKey1 := secp256k1.GenPrivKeyFromSecret([]byte("test1"))
+	addr1 := sdk.AccAddress(privKey1.PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test2")).PubKey().Address())
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: addr1.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+
+	encodeTx := func(nonce uint64) string {
+		tx := newTx(s.T(), s.App.TxConfig(), addr1, s.Ctx.ChainID(), types.AccountNumber, []sdk.Msg{msgSend}, nonce, privKey1)
+		txBytes, err := s.App.TxConfig().TxEncoder()(tx)
+		s.Require().NoError(err)
+		return s.onionMemo(txBytes)
+	}
+
+	specs := map[string]struct {
+		memo     func() string
+		expErr   error
+		expStage types.ExecutionStage
+	}{
+		"invalid base64 tx": {
+			memo:     func() string { return `{"onion":{"tx":"not base64!","version":1}}` },
+			expErr:   types.ErrInvalidMemo,
+			expStage: types.EXECUTION_STAGE_DECODE,
+		},
+		"unsupported envelope version": {
+			memo:     func() string { return `{"onion":{"tx":"aGVsbG8=","version":2}}` },
+			expErr:   types.ErrInvalidMemo,
+			expStage: types.EXECUTION_STAGE_DECODE,
+		},
+		"memo is not a tx": {
+			memo:     func() string { return s.onionMemo([]byte("hello")) },
+			expErr:   types.ErrTxDecode,
+			expStage: types.EXECUTION_STAGE_DECODE,
+		},
+		"more signer infos than signatures": {
+			memo: func() string {
+				tx := newTx(s.T(), s.App.TxConfig(), addr1, s.Ctx.ChainID(), types.AccountNumber, []sdk.Msg{msgSend}, 0, privKey1)
+				txBytes, err := s.App.TxConfig().TxEncoder()(tx)
+				s.Require().NoError(err)
+
+				var raw txtypes.TxRaw
+				s.Require().NoError(proto.Unmarshal(txBytes, &raw))
+				var authInfo txtypes.AuthInfo
+				s.Require().NoError(proto.Unmarshal(raw.AuthInfoBytes, &authInfo))
+				authInfo.SignerInfos = append(authInfo.SignerInfos, authInfo.SignerInfos[0])
+				raw.AuthInfoBytes, err = proto.Marshal(&authInfo)
+				s.Require().NoError(err)
+				txBytes, err = proto.Marshal(&raw)
+				s.Require().NoError(err)
+				return s.onionMemo(txBytes)
+			},
+			expErr:   types.ErrTxDecode,
+			expStage: types.EXECUTION_STAGE_DECODE,
+		},
+		"ante failure": {
+			memo:     func() string { return encodeTx(1) },
+			expErr:   types.ErrAnteFailed,
+			expStage: types.EXECUTION_STAGE_ANTE,
+		},
+		"execution failure": {
+			memo:     func() string { return encodeTx(0) },
+			expErr:   types.ErrExecuteFailed,
+			expStage: types.EXECUTION_STAGE_EXECUTE,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			s.SetupTest()
+			err := s.App.OnionKeeper.HandleTransferHook(s.Ctx, testPacket, testRelayer, testPacketData(spec.memo()), s.App.TxConfig())
+			s.Require().ErrorIs(err, spec.expErr)
+
+			event := s.onionExecutionEvent(s.Ctx)
+			s.Require().False(event.Success)
+			s.Require().Equal(spec.expStage, event.FailedStage)
+			s.Require().Equal(err.Error(), event.Error)
+
+			// the sequence is used once the ante checks pass
+			expSeq := uint64(0)
+			if spec.expStage == types.EXECUTION_STAGE_EXECUTE {
+				expSeq = 1
+			}
+			seq, err := s.App.OnionKeeper.GetSequence(s.Ctx, addr1.String())
+			s.Require().NoError(err)
+			s.Require().Equal(expSeq, seq.Sequence)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestOnReceivePacketHookGas() {
+	privKey1 := secp256k1.GenPrivKeyFromSecret([]byte("test1"))
+	addr1 := sdk.AccAddress(privKey1.PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test2")).PubKey().Address())
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: addr1.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+
+	fundAccount := func() {
+		coins := sdk.Coins{sdk.NewInt64Coin("test", 500)}
+		err := s.App.BankKeeper.MintCoins(s.Ctx, minttypes.ModuleName, coins)
+		s.Require().NoError(err)
+		err = s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, minttypes.ModuleName, addr1, coins)
+		s.Require().NoError(err)
+	}
+
+	// gas consumed by the ante checks alone, independent of the gas limit
+	s.SetupTest()
+	fundAccount()
+	anteCtx, _ := s.Ctx.CacheContext()
+	anteCtx = anteCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	tx := newTx(s.T(), s.App.TxConfig(), addr1, s.Ctx.ChainID(), types.AccountNumber, []sdk.Msg{msgSend}, 0, privKey1)
+	s.Require().NoError(s.App.OnionKeeper.ExecuteAnte(anteCtx, tx, testRelayer))
+	anteGas := anteCtx.GasMeter().GasConsumed()
+
+	specs := map[string]struct {
+		txGasLimit  uint64
+		maxGas      uint64
+		expGasLimit uint64
+		expErr      error
+		expErrMsg   string
+	}{
+		"tx gas limit below max gas": {
+			txGasLimit:  200_000,
+			maxGas:      types.DefaultMaxGas,
+			expGasLimit: 200_000,
+		},
+		"max gas below tx gas limit": {
+			txGasLimit:  types.DefaultMaxGas,
+			maxGas:      200_000,
+			expGasLimit: 200_000,
+		},
+		"out of gas in ante": {
+			txGasLimit:  200_000,
+			maxGas:      anteGas - 1,
+			expGasLimit: anteGas - 1,
+			expErr:      types.ErrAnteFailed,
+			expErrMsg:   "out of gas",
+		},
+		"out of gas in message handler": {
+			txGasLimit:  anteGas + 1,
+			maxGas:      types.DefaultMaxGas,
+			expGasLimit: anteGas + 1,
+			expErr:      types.ErrExecuteFailed,
+			expErrMsg:   "out of gas",
+		},
+		"zero tx gas limit": {
+			txGasLimit: 0,
+			maxGas:     types.DefaultMaxGas,
+			expErr:     types.ErrAnteFailed,
+			expErrMsg:  "gas limit must be positive",
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			s.SetupTest()
+			fundAccount()
+
+			params := types.DefaultParams()
+			params.MaxGas = spec.maxGas
+			s.Require().NoError(s.App.OnionKeeper.SetParams(s.Ctx, params))
+
+			tx := newTxWith(s.T(), s.App.TxConfig(), addr1, s.Ctx.ChainID(), types.AccountNumber, []sdk.Msg{msgSend}, 0, privKey1, func(b client.TxBuilder) {
+				b.SetGasLimit(spec.txGasLimit)
+			})
+			txBytes, err := s.App.TxConfig().TxEncoder()(tx)
+			s.Require().NoError(err)
+
+			s.Ctx = s.Ctx.WithGasMeter(storetypes.NewGasMeter(10_000_000))
+			err = s.App.OnionKeeper.HandleTransferHook(s.Ctx, testPacket, testRelayer, testPacketData(s.onionMemo(txBytes)), s.App.TxConfig())
+
+			event := s.onionExecutionEvent(s.Ctx)
+			s.Require().Equal(spec.expGasLimit, event.GasLimit)
+			s.Require().GreaterOrEqual(s.Ctx.GasMeter().GasConsumed(), event.GasUsed)
+			if spec.expErr != nil {
+				s.Require().ErrorIs(err, spec.expErr)
+				s.Require().ErrorContains(err, spec.expErrMsg)
+				s.Require().Equal(spec.expGasLimit, event.GasUsed)
+				s.Require().Equal(int64(0), s.App.BankKeeper.GetBalance(s.Ctx, addr2, "test").Amount.Int64())
+			} else {
+				s.Require().NoError(err)
+				s.Require().Positive(event.GasUsed)
+				s.Require().Less(event.GasUsed, spec.expGasLimit)
+				s.Require().Equal(int64(100), s.App.BankKeeper.GetBalance(s.Ctx, addr2, "test").Amount.Int64())
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRunWithGasMeter() {
+	specs := map[string]struct {
+		fn     func(sdk.Context) error
+		expErr error
+	}{
+		"no panic": {
+			fn: func(sdk.Context) error { return nil },
+		},
+		"error": {
+			fn:     func(sdk.Context) error { return types.ErrSample },
+			expErr: types.ErrSample,
+		},
+		"out of gas": {
+			fn: func(ctx sdk.Context) error {
+				ctx.GasMeter().ConsumeGas(2, "test")
+				return nil
+			},
+			expErr: sdkerrors.ErrOutOfGas,
+		},
+		"other panic": {
+			fn: func(sdk.Context) error {
+				var coins sdk.Coins
+				_ = coins[1]
+				return nil
+			},
+			expErr: sdkerrors.ErrPanic,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			ctx := s.Ctx.WithGasMeter(storetypes.NewGasMeter(1))
+			err := s.App.OnionKeeper.RunWithGasMeter(ctx, spec.fn)
+			if spec.expErr != nil {
+				s.Require().ErrorIs(err, spec.expErr)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestOnReceivePacketHookMemoFormats() {
+	privKey1 := secp256k1.GenPrivKeyFromSecret([]byte("test1"))
+	addr1 := sdk.AccAddress(privKey1.PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test2")).PubKey().Address())
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: addr1.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	tx := newTx(s.T(), s.App.TxConfig(), addr1, s.Ctx.ChainID(), types.AccountNumber, []sdk.Msg{msgSend}, 0, privKey1)
+	txBytes, err := s.App.TxConfig().TxEncoder()(tx)
+	s.Require().NoError(err)
+	txBase64 := base64.StdEncoding.EncodeToString(txBytes)
+
+	specs := map[string]struct {
+		memo       string
+		legacyMemo bool
+		expExecute bool
+	}{
+		"json envelope": {
+			memo:       s.onionMemo(txBytes),
+			expExecute: true,
+		},
+		"json envelope next to other middlewares": {
+			memo:       fmt.Sprintf(`{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-0"},"onion":{"tx":%q,"version":1}}`, txBase64),
+			expExecute: true,
+		},
+		"other middleware only": {
+			memo: `{"wasm":{"contract":"cosmos1","msg":{}}}`,
+		},
+		"free text": {
+			memo: "thanks for the coffee",
+		},
+		"free text with legacy memo enabled": {
+			memo:       "deposit 123",
+			legacyMemo: true,
+		},
+		"invalid json with legacy memo enabled": {
+			memo:       "{oops",
+			legacyMemo: true,
+		},
+		"legacy memo disabled": {
+			memo: txBase64,
+		},
+		"legacy memo enabled": {
+			memo:       txBase64,
+			legacyMemo: true,
+			expExecute: true,
+		},
+		"json envelope with legacy memo enabled": {
+			memo:       s.onionMemo(txBytes),
+			legacyMemo: true,
+			expExecute: true,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			s.SetupTest()
+			coins := sdk.Coins{sdk.NewInt64Coin("test", 500)}
+			s.Require().NoError(s.App.BankKeeper.MintCoins(s.Ctx, minttypes.ModuleName, coins))
+			s.Require().NoError(s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, minttypes.ModuleName, addr1, coins))
+
+			params := types.DefaultParams()
+			params.LegacyMemo = spec.legacyMemo
+			s.Require().NoError(s.App.OnionKeeper.SetParams(s.Ctx, params))
+
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+			err := s.App.OnionKeeper.HandleTransferHook(s.Ctx, testPacket, testRelayer, testPacketData(spec.memo), s.App.TxConfig())
+			s.Require().NoError(err)
+
+			seq, err := s.App.OnionKeeper.GetSequence(s.Ctx, addr1.String())
+			s.Require().NoError(err)
+			if spec.expExecute {
+				s.Require().Equal(uint64(1), seq.Sequence)
+				s.Require().True(s.onionExecutionEvent(s.Ctx).Success)
+			} else {
+				s.Require().Equal(uint64(0), seq.Sequence)
+				for _, ev := range s.Ctx.EventManager().Events() {
+					s.Require().NotEqual(proto.MessageName(&types.EventOnionExecution{}), ev.Type)
+				}
 			}
 		})
 	}
@@ -25,8 +25,15 @@ type (
 		authority string
 
 		accountKeeper   types.AccountKeeper
+		bankKeeper      types.BankKeeper
+		feegrantKeeper  types.FeegrantKeeper
 		router          *baseapp.MsgServiceRouter
 		SignModeHandler *txsigning.HandlerMap
+		txDecoder       sdk.TxDecoder
+
+		// set once the IBC keepers exist, see SetTransferKeepers
+		channelKeeper  types.ChannelKeeper
+		transferKeeper types.TransferKeeper
 	}
 )
 
@@ -38,7 +45,10 @@ func NewKeeper(
 	authority string,
 	router *baseapp.MsgServiceRouter,
 	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	feegrantKeeper types.FeegrantKeeper,
 	signModeHandler *txsigning.HandlerMap,
+	txDecoder sdk.TxDecoder,
 ) *Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address: %s", authority))
@@ -50,10 +60,21 @@ func NewKeeper(
 		logger:          logger,
 		router:          router,
 		accountKeeper:   accountKeeper,
+		bankKeeper:      bankKeeper,
+		feegrantKeeper:  feegrantKeeper,
 		SignModeHandler: signModeHandler,
+		txDecoder:       txDecoder,
 	}
 }
 
+// SetTransferKeepers sets the IBC channel and ICS-20 transfer keepers, which
+// are created after the onion keeper. SimulateOnion credits the funds of the
+// simulated transfer through them.
+func (k *Keeper) SetTransferKeepers(channelKeeper types.ChannelKeeper, transferKeeper types.TransferKeeper) {
+	k.channelKeeper = channelKeeper
+	k.transferKeeper = transferKeeper
+}
+
 // GetAuthority returns the module's authority.
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -71,11 +92,49 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, nonces := range genState.UnorderedNonces {
+		if err := k.SetUnorderedNonces(ctx, nonces); err != nil {
+			panic(err)
+		}
+	}
+	for _, receipt := range genState.ExecutionReceipts {
+		if err := k.SetExecutionReceipt(ctx, receipt); err != nil {
+			panic(err)
+		}
+	}
+	for _, queued := range genState.QueuedTxs {
+		if err := k.SetQueuedTx(ctx, queued); err != nil {
+			panic(err)
+		}
+	}
+	if genState.NextQueuedTxId > 0 {
+		k.SetNextQueuedTxID(ctx, genState.NextQueuedTxId)
+	}
 }
 
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	sequences, err := k.GetAllSequences(ctx)
+	if err != nil {
+		panic(err)
+	}
+	unorderedNonces, err := k.GetAllUnorderedNonces(ctx)
+	if err != nil {
+		panic(err)
+	}
+	receipts, err := k.GetAllExecutionReceipts(ctx)
+	if err != nil {
+		panic(err)
+	}
+	queuedTxs, err := k.GetAllQueuedTxs(ctx)
+	if err != nil {
+		panic(err)
+	}
 	return &types.GenesisState{
-		Params:    k.GetParams(ctx),
-		Sequences: k.GetAllSequences(ctx),
+		Params:            k.GetParams(ctx),
+		Sequences:         sequences,
+		UnorderedNonces:   unorderedNonces,
+		ExecutionReceipts: receipts,
+		QueuedTxs:         queuedTxs,
+		NextQueuedTxId:    k.GetNextQueuedTxID(ctx),
 	}
 }
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"onion/x/onion/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 fills in the params added in version 2 on chains whose params
+// were stored before they existed. The max_gas param is set as every onion
+// tx would run out of gas with a zero cap, receipt_retention_blocks as no
+// receipt would be stored with a zero retention, legacy_memo keeps the raw
+// base64 memos, the only format of version 1, executing. Version 1 executed
+// onion txs on every channel, the existing ICS-20 channels that are not
+// closed are enabled so the upgrade does not turn the module off, channels
+// opened later must be enabled by governance.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	if params.MaxGas == 0 {
+		params.MaxGas = types.DefaultMaxGas
+	}
+	if params.ReceiptRetentionBlocks == 0 {
+		params.ReceiptRetentionBlocks = types.DefaultReceiptRetentionBlocks
+	}
+	params.LegacyMemo = true
+	if len(params.EnabledChannels) == 0 && m.keeper.channelKeeper != nil {
+		params.EnabledChannels = transferChannels(ctx, m.keeper.channelKeeper)
+	}
+	return m.keeper.SetParams(ctx, params)
+}
+
+// transferChannels returns the channels bound to the ICS-20 transfer port
+// that are not closed.
+func transferChannels(ctx sdk.Context, channelKeeper types.ChannelKeeper) []types.EnabledChannel {
+	var channels []types.EnabledChannel
+	for _, channel := range channelKeeper.GetAllChannelsWithPortPrefix(ctx, transfertypes.PortID) {
+		if channel.PortId != transfertypes.PortID || channel.State == channeltypes.CLOSED {
+			continue
+		}
+		channels = append(channels, types.EnabledChannel{PortId: channel.PortId, ChannelId: channel.ChannelId})
+	}
+	return channels
+}
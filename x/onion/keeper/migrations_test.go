@@ -0,0 +1,66 @@
+package keeper_test
+
+import (
+	"testing"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "onion/testutil/keeper"
+	"onion/x/onion/keeper"
+	"onion/x/onion/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	k, ctx := keepertest.OnionKeeper(t)
+
+	// params stored before max_gas and receipt_retention_blocks existed
+	params := types.DefaultParams()
+	params.MaxGas = 0
+	params.ReceiptRetentionBlocks = 0
+	require.NoError(t, k.SetParams(ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+	require.Equal(t, types.DefaultMaxGas, k.GetParams(ctx).MaxGas)
+	require.Equal(t, types.DefaultReceiptRetentionBlocks, k.GetParams(ctx).ReceiptRetentionBlocks)
+	// raw base64 memos, the only format before the JSON envelope, keep
+	// executing
+	require.True(t, k.GetParams(ctx).LegacyMemo)
+
+	// values set by governance are kept
+	params.MaxGas = 42
+	params.ReceiptRetentionBlocks = 7
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+	require.Equal(t, uint64(42), k.GetParams(ctx).MaxGas)
+	require.Equal(t, uint64(7), k.GetParams(ctx).ReceiptRetentionBlocks)
+}
+
+func (s *KeeperTestSuite) TestMigrate1to2EnabledChannels() {
+	// params stored before enabled_channels existed
+	s.Require().NoError(s.App.OnionKeeper.SetParams(s.Ctx, types.Params{}))
+
+	channelKeeper := s.App.IBCKeeper.ChannelKeeper
+	channelKeeper.SetChannel(s.Ctx, transfertypes.PortID, "channel-0", channeltypes.Channel{State: channeltypes.OPEN})
+	channelKeeper.SetChannel(s.Ctx, transfertypes.PortID, "channel-1", channeltypes.Channel{State: channeltypes.CLOSED})
+	channelKeeper.SetChannel(s.Ctx, transfertypes.PortID, "channel-2", channeltypes.Channel{State: channeltypes.TRYOPEN})
+	channelKeeper.SetChannel(s.Ctx, "icahost", "channel-3", channeltypes.Channel{State: channeltypes.OPEN})
+
+	s.Require().NoError(keeper.NewMigrator(s.App.OnionKeeper).Migrate1to2(s.Ctx))
+
+	// the transfer channels that are not closed keep executing onion txs
+	params := s.App.OnionKeeper.GetParams(s.Ctx)
+	s.Require().Equal([]types.EnabledChannel{
+		{PortId: transfertypes.PortID, ChannelId: "channel-0"},
+		{PortId: transfertypes.PortID, ChannelId: "channel-2"},
+	}, params.EnabledChannels)
+	s.Require().True(params.IsChannelEnabled(transfertypes.PortID, "channel-0"))
+	s.Require().False(params.IsChannelEnabled(transfertypes.PortID, "channel-1"))
+
+	// channels enabled by governance are kept
+	params.EnabledChannels = []types.EnabledChannel{{PortId: transfertypes.PortID, ChannelId: "channel-1"}}
+	s.Require().NoError(s.App.OnionKeeper.SetParams(s.Ctx, params))
+	s.Require().NoError(keeper.NewMigrator(s.App.OnionKeeper).Migrate1to2(s.Ctx))
+	s.Require().Equal(params.EnabledChannels, s.App.OnionKeeper.GetParams(s.Ctx).EnabledChannels)
+}
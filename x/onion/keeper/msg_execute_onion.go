@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"onion/x/onion/types"
+)
+
+// ExecuteOnion executes the submitted onion tx. An onion tx that fails to
+// decode or in the ante checks fails the msg, so nothing but the gas of the
+// submitter is spent on it. The outcome of the execution is part of the
+// response otherwise.
+func (k msgServer) ExecuteOnion(goCtx context.Context, req *types.MsgExecuteOnion) (*types.MsgExecuteOnionResponse, error) {
+	submitter, err := sdk.AccAddressFromBech32(req.Submitter)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid submitter address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	event, results, err := k.Keeper.ExecuteOnion(ctx, submitter, req.TxBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.MsgExecuteOnionResponse{
+		TxHash:      event.TxHash,
+		Success:     event.Success,
+		FailedStage: event.FailedStage,
+		Error:       event.Error,
+	}
+	for _, result := range results {
+		res.MsgResponses = append(res.MsgResponses, result.MsgResponses...)
+	}
+	return res, nil
+}
@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"onion/x/onion/keeper"
+	"onion/x/onion/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (s *KeeperTestSuite) TestMsgExecuteOnion() {
+	privKey1 := secp256k1.GenPrivKeyFromSecret([]byte("test1"))
+	addr1 := sdk.AccAddress(privKey1.PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test2")).PubKey().Address())
+	submitter := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("submitter")).PubKey().Address())
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: addr1.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	msgSendTooMuch := &banktypes.MsgSend{
+		FromAddress: addr1.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 1000)},
+	}
+	fee := sdk.NewCoins(sdk.NewInt64Coin("test", 10))
+	encodeTx := func(msg sdk.Msg, nonce uint64) []byte {
+		tx := newTxWith(s.T(), s.App.TxConfig(), addr1, s.Ctx.ChainID(), types.AccountNumber, []sdk.Msg{msg}, nonce, privKey1, func(b client.TxBuilder) {
+			b.SetFeeAmount(fee)
+		})
+		txBytes, err := s.App.TxConfig().TxEncoder()(tx)
+		s.Require().NoError(err)
+		return txBytes
+	}
+
+	specs := map[string]struct {
+		txBytes    func() []byte
+		expErr     error
+		expOnion   uint64
+		expSuccess bool
+	}{
+		"success": {
+			txBytes:    func() []byte { return encodeTx(msgSend, 0) },
+			expOnion:   1,
+			expSuccess: true,
+		},
+		"messages fail": {
+			txBytes:  func() []byte { return encodeTx(msgSendTooMuch, 0) },
+			expOnion: 1,
+		},
+		"wrong onion sequence": {
+			txBytes: func() []byte { return encodeTx(msgSend, 1) },
+			expErr:  types.ErrAnteFailed,
+		},
+		"not a tx": {
+			txBytes: func() []byte { return []byte("hello") },
+			expErr:  types.ErrTxDecode,
+		},
+	}
+	for name, spec := range specs {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.fund(addr1, sdk.NewCoins(sdk.NewInt64Coin("test", 500)))
+			ms := keeper.NewMsgServerImpl(s.App.OnionKeeper)
+
+			ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+			gasBefore := ctx.GasMeter().GasConsumed()
+			res, err := ms.ExecuteOnion(ctx, types.NewMsgExecuteOnion(submitter.String(), spec.txBytes()))
+			seq, seqErr := s.App.OnionKeeper.GetSequence(s.Ctx, addr1.String())
+			s.Require().NoError(seqErr)
+			s.Require().Equal(spec.expOnion, seq.Sequence)
+
+			event := s.onionExecutionEvent(ctx)
+			s.Require().Equal(submitter.String(), event.Submitter)
+			s.Require().Empty(event.DestinationChannel)
+			if spec.expErr != nil {
+				s.Require().ErrorIs(err, spec.expErr)
+				s.Require().False(event.Success)
+				s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, submitter).IsZero())
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(spec.expSuccess, event.Success)
+			s.Require().Equal(event.Success, res.Success)
+			s.Require().Equal(event.FailedStage, res.FailedStage)
+			s.Require().Equal(event.Error, res.Error)
+			s.Require().Equal(event.TxHash, res.TxHash)
+			s.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed()-gasBefore, event.GasUsed)
+
+			// the submitter takes the fee in place of a relayer, also when
+			// the messages fail
+			s.Require().Equal(fee, s.App.BankKeeper.GetAllBalances(s.Ctx, submitter))
+			if !spec.expSuccess {
+				s.Require().Equal(types.EXECUTION_STAGE_EXECUTE, res.FailedStage)
+				s.Require().Contains(res.Error, types.ErrExecuteFailed.Error())
+				s.Require().Empty(res.MsgResponses)
+				s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, addr2).IsZero())
+				return
+			}
+			s.Require().Len(res.MsgResponses, 1)
+			s.Require().Equal(msgSend.Amount, s.App.BankKeeper.GetAllBalances(s.Ctx, addr2))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgExecuteOnionReplay() {
+	privKey1 := secp256k1.GenPrivKeyFromSecret([]byte("test1"))
+	addr1 := sdk.AccAddress(privKey1.PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test2")).PubKey().Address())
+
+	s.SetupTest()
+	s.fund(addr1, sdk.NewCoins(sdk.NewInt64Coin("test", 500)))
+	msgSend := &banktypes.MsgSend{
+		FromAddress: addr1.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	tx := newTx(s.T(), s.App.TxConfig(), addr1, s.Ctx.ChainID(), types.AccountNumber, []sdk.Msg{msgSend}, 0, privKey1)
+	txBytes, err := s.App.TxConfig().TxEncoder()(tx)
+	s.Require().NoError(err)
+
+	ms := keeper.NewMsgServerImpl(s.App.OnionKeeper)
+	_, err = ms.ExecuteOnion(s.Ctx, types.NewMsgExecuteOnion(addr2.String(), txBytes))
+	s.Require().NoError(err)
+
+	// neither a second submission nor the packet carrying the same tx is
+	// executed again
+	_, err = ms.ExecuteOnion(s.Ctx, types.NewMsgExecuteOnion(addr2.String(), txBytes))
+	s.Require().ErrorIs(err, types.ErrAnteFailed)
+	err = s.App.OnionKeeper.HandleTransferHook(s.Ctx, testPacket, testRelayer, testPacketData(s.onionMemo(txBytes)), s.App.TxConfig())
+	s.Require().ErrorIs(err, types.ErrAnteFailed)
+
+	s.Require().Equal(msgSend.Amount, s.App.BankKeeper.GetAllBalances(s.Ctx, addr2))
+}
@@ -0,0 +1,12 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CheckMsgTypes rejects msgs containing a message type, at the top level or
+// nested in another message, that is not allowed by the allowed_msg_types and
+// denied_msg_types params.
+func (k Keeper) CheckMsgTypes(ctx sdk.Context, msgs []sdk.Msg) error {
+	return k.GetParams(ctx).CheckMsgTypes(msgs)
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"onion/x/onion/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func (s *KeeperTestSuite) TestMsgTypeFilter() {
+	privKey1 := secp256k1.GenPrivKeyFromSecret([]byte("test1"))
+	addr1 := sdk.AccAddress(privKey1.PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test2")).PubKey().Address())
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: addr1.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+
+	specs := map[string]struct {
+		allowed []string
+		denied  []string
+		expErr  bool
+	}{
+		"allowed by wildcard": {
+			allowed: []string{"/cosmos.bank.*"},
+		},
+		"not in allowlist": {
+			allowed: []string{"/cosmos.staking.*"},
+			expErr:  true,
+		},
+		"denied by wildcard": {
+			denied: []string{"/cosmos.bank.*"},
+			expErr: true,
+		},
+		"denied overrides allowed": {
+			allowed: []string{"/cosmos.bank.*"},
+			denied:  []string{"/cosmos.bank.v1beta1.MsgSend"},
+			expErr:  true,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			s.SetupTest()
+			coins := sdk.Coins{sdk.NewInt64Coin("test", 500)}
+			s.Require().NoError(s.App.BankKeeper.MintCoins(s.Ctx, minttypes.ModuleName, coins))
+			s.Require().NoError(s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, minttypes.ModuleName, addr1, coins))
+
+			params := types.DefaultParams()
+			params.AllowedMsgTypes = spec.allowed
+			params.DeniedMsgTypes = spec.denied
+			s.Require().NoError(s.App.OnionKeeper.SetParams(s.Ctx, params))
+
+			tx := newTx(s.T(), s.App.TxConfig(), addr1, s.Ctx.ChainID(), types.AccountNumber, []sdk.Msg{msgSend}, 0, privKey1)
+
+			// ante
+			anteCtx, _ := s.Ctx.CacheContext()
+			err := s.App.OnionKeeper.ExecuteAnte(anteCtx, tx, testRelayer)
+			if spec.expErr {
+				s.Require().ErrorIs(err, types.ErrMsgNotAllowed)
+			} else {
+				s.Require().NoError(err)
+			}
+
+			// hook
+			txBytes, err := s.App.TxConfig().TxEncoder()(tx)
+			s.Require().NoError(err)
+			err = s.App.OnionKeeper.HandleTransferHook(s.Ctx, testPacket, testRelayer, testPacketData(s.onionMemo(txBytes)), s.App.TxConfig())
+			if spec.expErr {
+				s.Require().ErrorIs(err, types.ErrAnteFailed)
+				s.Require().ErrorContains(err, "/cosmos.bank.v1beta1.MsgSend")
+				s.Require().Equal(types.EXECUTION_STAGE_ANTE, s.onionExecutionEvent(s.Ctx).FailedStage)
+				s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, addr2, "test").IsZero())
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(int64(100), s.App.BankKeeper.GetBalance(s.Ctx, addr2, "test").Amount.Int64())
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgTypeFilterNested() {
+	privKey1 := secp256k1.GenPrivKeyFromSecret([]byte("test1"))
+	addr1 := sdk.AccAddress(privKey1.PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test2")).PubKey().Address())
+
+	s.SetupTest()
+	s.fund(addr1, sdk.NewCoins(sdk.NewInt64Coin("test", 500)))
+
+	params := types.DefaultParams()
+	params.DeniedMsgTypes = []string{"/cosmos.bank.v1beta1.MsgSend"}
+	s.Require().NoError(s.App.OnionKeeper.SetParams(s.Ctx, params))
+
+	// the grantee is the granter, so the exec needs no grant
+	exec := authz.NewMsgExec(addr1, []sdk.Msg{&banktypes.MsgSend{
+		FromAddress: addr1.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin("test", 100)),
+	}})
+	tx := newTx(s.T(), s.App.TxConfig(), addr1, s.Ctx.ChainID(), types.AccountNumber, []sdk.Msg{&exec}, 0, privKey1)
+	txBytes, err := s.App.TxConfig().TxEncoder()(tx)
+	s.Require().NoError(err)
+
+	err = s.App.OnionKeeper.HandleTransferHook(s.Ctx, testPacket, testRelayer, testPacketData(s.onionMemo(txBytes)), s.App.TxConfig())
+	s.Require().ErrorIs(err, types.ErrAnteFailed)
+	s.Require().ErrorContains(err, "/cosmos.bank.v1beta1.MsgSend")
+	s.Require().Equal(types.EXECUTION_STAGE_ANTE, s.onionExecutionEvent(s.Ctx).FailedStage)
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, addr2).IsZero())
+
+	// with the filter lifted the same tx executes
+	s.Require().NoError(s.App.OnionKeeper.SetParams(s.Ctx, types.DefaultParams()))
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	err = s.App.OnionKeeper.HandleTransferHook(s.Ctx, testPacket, testRelayer, testPacketData(s.onionMemo(txBytes)), s.App.TxConfig())
+	s.Require().NoError(err)
+	s.Require().Equal(int64(100), s.App.BankKeeper.GetBalance(s.Ctx, addr2, "test").Amount.Int64())
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"onion/x/onion/types"
+)
+
+// RetryOnion executes a queued onion tx again. The msg succeeds whenever the
+// tx could be retried, the outcome is part of the response.
+func (k msgServer) RetryOnion(goCtx context.Context, req *types.MsgRetryOnion) (*types.MsgRetryOnionResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	event, results, err := k.Keeper.RetryOnion(ctx, sender, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.MsgRetryOnionResponse{
+		Success:     event.Success,
+		FailedStage: event.FailedStage,
+		Error:       event.Error,
+	}
+	for _, result := range results {
+		res.MsgResponses = append(res.MsgResponses, result.MsgResponses...)
+	}
+	return res, nil
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -32,13 +33,128 @@ func TestMsgUpdateParams(t *testing.T) {
 			expErrMsg: "invalid authority",
 		},
 		{
-			name: "send enabled param",
+			name: "atomic execution mode",
 			input: &types.MsgUpdateParams{
 				Authority: k.GetAuthority(),
-				Params:    types.Params{},
+				Params:    types.NewParams(types.EXECUTION_MODE_ATOMIC, types.DefaultMaxGas, sdk.DecCoins{}, nil, nil, nil, false, 0, 0),
 			},
 			expErr: false,
 		},
+		{
+			name: "invalid execution mode",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params:    types.NewParams(5, types.DefaultMaxGas, sdk.DecCoins{}, nil, nil, nil, false, 0, 0),
+			},
+			expErr:    true,
+			expErrMsg: "unknown execution mode",
+		},
+		{
+			name: "zero max gas",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params:    types.NewParams(types.EXECUTION_MODE_BEST_EFFORT, 0, sdk.DecCoins{}, nil, nil, nil, false, 0, 0),
+			},
+			expErr:    true,
+			expErrMsg: "max gas must be positive",
+		},
+		{
+			name: "min gas prices",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params: types.NewParams(types.EXECUTION_MODE_BEST_EFFORT, types.DefaultMaxGas, sdk.NewDecCoins(
+					sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(25, 3)),
+				), nil, nil, nil, false, 0, 0),
+			},
+			expErr: false,
+		},
+		{
+			name: "invalid min gas prices",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params: types.NewParams(types.EXECUTION_MODE_BEST_EFFORT, types.DefaultMaxGas, sdk.DecCoins{
+					{Denom: "stake", Amount: math.LegacyNewDec(-1)},
+				}, nil, nil, nil, false, 0, 0),
+			},
+			expErr:    true,
+			expErrMsg: "invalid min gas prices",
+		},
+		{
+			name: "msg type filters",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params: types.NewParams(types.EXECUTION_MODE_BEST_EFFORT, types.DefaultMaxGas, nil,
+					[]string{"/cosmos.bank.*", "/cosmos.staking.v1beta1.MsgDelegate"},
+					[]string{"/cosmos.bank.v1beta1.MsgMultiSend"},
+					nil,
+					false,
+					0,
+					0,
+				),
+			},
+			expErr: false,
+		},
+		{
+			name: "invalid allowed msg type",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params:    types.NewParams(types.EXECUTION_MODE_BEST_EFFORT, types.DefaultMaxGas, nil, []string{"cosmos.bank.*"}, nil, nil, false, 0, 0),
+			},
+			expErr:    true,
+			expErrMsg: "invalid allowed msg types",
+		},
+		{
+			name: "invalid denied msg type wildcard",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params:    types.NewParams(types.EXECUTION_MODE_BEST_EFFORT, types.DefaultMaxGas, nil, nil, []string{"/cosmos.*.MsgSend"}, nil, false, 0, 0),
+			},
+			expErr:    true,
+			expErrMsg: "invalid denied msg types",
+		},
+		{
+			name: "duplicate denied msg type",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params:    types.NewParams(types.EXECUTION_MODE_BEST_EFFORT, types.DefaultMaxGas, nil, nil, []string{"/ibc.*", "/ibc.*"}, nil, false, 0, 0),
+			},
+			expErr:    true,
+			expErrMsg: "duplicate msg type",
+		},
+		{
+			name: "enabled channels",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params: types.NewParams(types.EXECUTION_MODE_BEST_EFFORT, types.DefaultMaxGas, nil, nil, nil, []types.EnabledChannel{
+					{PortId: "transfer", ChannelId: "channel-0"},
+					{PortId: "transfer", ChannelId: "channel-12"},
+				}, false, 0, 0),
+			},
+			expErr: false,
+		},
+		{
+			name: "invalid enabled channel id",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params: types.NewParams(types.EXECUTION_MODE_BEST_EFFORT, types.DefaultMaxGas, nil, nil, nil, []types.EnabledChannel{
+					{PortId: "transfer", ChannelId: ""},
+				}, false, 0, 0),
+			},
+			expErr:    true,
+			expErrMsg: "invalid enabled channels",
+		},
+		{
+			name: "duplicate enabled channel",
+			input: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params: types.NewParams(types.EXECUTION_MODE_BEST_EFFORT, types.DefaultMaxGas, nil, nil, nil, []types.EnabledChannel{
+					{PortId: "transfer", ChannelId: "channel-0"},
+					{PortId: "transfer", ChannelId: "channel-0"},
+				}, false, 0, 0),
+			},
+			expErr:    true,
+			expErrMsg: "duplicate channel",
+		},
 		{
 			name: "all good",
 			input: &types.MsgUpdateParams{
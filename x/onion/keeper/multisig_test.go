@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"context"
+
+	"onion/x/onion/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdktx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	kmultisig "github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// newOnionKeyring returns an in-memory keyring with a key for every name.
+func (s *KeeperTestSuite) newOnionKeyring(names ...string) (keyring.Keyring, []cryptotypes.PubKey) {
+	kr := keyring.NewInMemory(s.App.AppCodec())
+	pubKeys := make([]cryptotypes.PubKey, len(names))
+	for i, name := range names {
+		record, _, err := kr.NewMnemonic(name, keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		s.Require().NoError(err)
+		pubKeys[i], err = record.GetPubKey()
+		s.Require().NoError(err)
+	}
+	return kr, pubKeys
+}
+
+// signPartial signs the tx of builder with the key name in amino json sign
+// mode for the onion sequence seq, as the sign-partial command does, and
+// returns the signature.
+func (s *KeeperTestSuite) signPartial(kr keyring.Keyring, builder client.TxBuilder, name string, seq uint64) signingtypes.SignatureV2 {
+	txf := sdktx.Factory{}.
+		WithTxConfig(s.App.TxConfig()).
+		WithKeybase(kr).
+		WithChainID(s.Ctx.ChainID()).
+		WithAccountNumber(types.AccountNumber).
+		WithSequence(seq).
+		WithSignMode(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	s.Require().NoError(sdktx.Sign(context.Background(), txf, name, builder, true))
+
+	sigs, err := builder.GetTx().GetSignaturesV2()
+	s.Require().NoError(err)
+	s.Require().Len(sigs, 1)
+	return sigs[0]
+}
+
+func (s *KeeperTestSuite) fund(addr sdk.AccAddress, coins sdk.Coins) {
+	s.Require().NoError(s.App.BankKeeper.MintCoins(s.Ctx, minttypes.ModuleName, coins))
+	s.Require().NoError(s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, minttypes.ModuleName, addr, coins))
+}
+
+func (s *KeeperTestSuite) TestMultisigOnionTx() {
+	specs := map[string]struct {
+		signers    []string
+		seq        uint64
+		expSuccess bool
+	}{
+		"two of three": {
+			signers:    []string{"key1", "key3"},
+			expSuccess: true,
+		},
+		"three of three": {
+			signers:    []string{"key1", "key2", "key3"},
+			expSuccess: true,
+		},
+		"one of three": {
+			signers: []string{"key2"},
+		},
+		"wrong onion sequence": {
+			signers: []string{"key1", "key2"},
+			seq:     1,
+		},
+	}
+	for name, spec := range specs {
+		s.Run(name, func() {
+			s.SetupTest()
+			kr, pubKeys := s.newOnionKeyring("key1", "key2", "key3")
+			multisigPub := kmultisig.NewLegacyAminoPubKey(2, pubKeys)
+			multisigAddr := sdk.AccAddress(multisigPub.Address())
+			recipient := sdk.AccAddress(pubKeys[0].Address())
+
+			coins := sdk.NewCoins(sdk.NewInt64Coin("test", 100))
+			s.fund(multisigAddr, coins)
+
+			builder := s.App.TxConfig().NewTxBuilder()
+			s.Require().NoError(builder.SetMsgs(&banktypes.MsgSend{
+				FromAddress: multisigAddr.String(),
+				ToAddress:   recipient.String(),
+				Amount:      coins,
+			}))
+			builder.SetGasLimit(200_000)
+
+			// multisign
+			multisigSig := multisig.NewMultisig(len(pubKeys))
+			for _, signer := range spec.signers {
+				sig := s.signPartial(kr, builder, signer, spec.seq)
+				s.Require().NoError(multisig.AddSignatureV2(multisigSig, sig, multisigPub.GetPubKeys()))
+			}
+			s.Require().NoError(builder.SetSignatures(signingtypes.SignatureV2{
+				PubKey:   multisigPub,
+				Data:     multisigSig,
+				Sequence: spec.seq,
+			}))
+			txBytes, err := s.App.TxConfig().TxEncoder()(builder.GetTx())
+			s.Require().NoError(err)
+
+			err = s.App.OnionKeeper.HandleTransferHook(s.Ctx, testPacket, testRelayer, testPacketData(s.onionMemo(txBytes)), s.App.TxConfig())
+			seq, seqErr := s.App.OnionKeeper.GetSequence(s.Ctx, multisigAddr.String())
+			s.Require().NoError(seqErr)
+			if !spec.expSuccess {
+				s.Require().ErrorIs(err, types.ErrAnteFailed)
+				s.Require().Equal(uint64(0), seq.Sequence)
+				s.Require().Equal(coins, s.App.BankKeeper.GetAllBalances(s.Ctx, multisigAddr))
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(1), seq.Sequence)
+			s.Require().Equal(coins, s.App.BankKeeper.GetAllBalances(s.Ctx, recipient))
+			s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, multisigAddr).IsZero())
+
+			event := s.onionExecutionEvent(s.Ctx)
+			s.Require().True(event.Success)
+			s.Require().Equal([]string{multisigAddr.String()}, event.Signers)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMultiSignerOnionTx() {
+	s.SetupTest()
+	kr, pubKeys := s.newOnionKeyring("key1", "key2")
+	addr1, addr2 := sdk.AccAddress(pubKeys[0].Address()), sdk.AccAddress(pubKeys[1].Address())
+	recipient := sdk.AccAddress([]byte("recipient"))
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 100))
+	s.fund(addr1, coins)
+	s.fund(addr2, coins)
+	// the signers sign with their own onion sequences
+	s.Require().NoError(s.App.OnionKeeper.SetSequence(s.Ctx, types.OnionSequence{Address: addr2.String(), Sequence: 4}))
+
+	builder := s.App.TxConfig().NewTxBuilder()
+	s.Require().NoError(builder.SetMsgs(
+		&banktypes.MsgSend{FromAddress: addr1.String(), ToAddress: recipient.String(), Amount: coins},
+		&banktypes.MsgSend{FromAddress: addr2.String(), ToAddress: recipient.String(), Amount: coins},
+	))
+	builder.SetGasLimit(300_000)
+
+	// assemble
+	sig1 := s.signPartial(kr, builder, "key1", 0)
+	sig2 := s.signPartial(kr, builder, "key2", 4)
+	s.Require().NoError(builder.SetSignatures(sig1, sig2))
+	txBytes, err := s.App.TxConfig().TxEncoder()(builder.GetTx())
+	s.Require().NoError(err)
+
+	err = s.App.OnionKeeper.HandleTransferHook(s.Ctx, testPacket, testRelayer, testPacketData(s.onionMemo(txBytes)), s.App.TxConfig())
+	s.Require().NoError(err)
+	s.Require().Equal(coins.Add(coins...), s.App.BankKeeper.GetAllBalances(s.Ctx, recipient))
+
+	seq, err := s.App.OnionKeeper.GetSequence(s.Ctx, addr1.String())
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), seq.Sequence)
+	seq, err = s.App.OnionKeeper.GetSequence(s.Ctx, addr2.String())
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(5), seq.Sequence)
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/gogoproto/proto"
+
+	"onion/x/onion/types"
+)
+
+// GetUnorderedNonces returns the unordered nonce window of an address.
+func (k Keeper) GetUnorderedNonces(ctx sdk.Context, address string) (types.UnorderedNonces, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, []byte(types.OnionUnorderedNoncesPrefix))
+	bz := prefixStore.Get([]byte(address))
+	if bz == nil {
+		return types.NewUnorderedNonces(address), nil
+	}
+	nonces := types.UnorderedNonces{}
+	if err := proto.Unmarshal(bz, &nonces); err != nil {
+		return types.UnorderedNonces{}, err
+	}
+	return nonces, nil
+}
+
+// SetUnorderedNonces stores the unordered nonce window of an address.
+func (k Keeper) SetUnorderedNonces(ctx sdk.Context, nonces types.UnorderedNonces) error {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, []byte(types.OnionUnorderedNoncesPrefix))
+
+	bz, err := proto.Marshal(&nonces)
+	if err != nil {
+		return err
+	}
+
+	prefixStore.Set([]byte(nonces.Address), bz)
+	return nil
+}
+
+// GetAllUnorderedNonces returns the unordered nonce windows of all addresses.
+func (k Keeper) GetAllUnorderedNonces(ctx sdk.Context) ([]types.UnorderedNonces, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.OnionUnorderedNoncesPrefix))
+	defer iterator.Close()
+
+	all := []types.UnorderedNonces{}
+	for ; iterator.Valid(); iterator.Next() {
+		nonces := types.UnorderedNonces{}
+		if err := proto.Unmarshal(iterator.Value(), &nonces); err != nil {
+			return nil, err
+		}
+		all = append(all, nonces)
+	}
+	return all, nil
+}
+
+// IsUnorderedTx reports whether the signers of an onion tx opted into
+// unordered nonces through ExtensionOptionUnorderedNonce.
+func IsUnorderedTx(tx sdk.Tx) bool {
+	extTx, ok := tx.(ante.HasExtensionOptionsTx)
+	if !ok {
+		return false
+	}
+	for _, option := range extTx.GetExtensionOptions() {
+		if _, ok := option.GetCachedValue().(*types.ExtensionOptionUnorderedNonce); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,77 @@
+package keeper_test
+
+import (
+	"onion/x/onion/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func (s *KeeperTestSuite) TestOnReceivePacketHookUnorderedNonces() {
+	privKey1 := secp256k1.GenPrivKeyFromSecret([]byte("test1"))
+	addr1 := sdk.AccAddress(privKey1.PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test2")).PubKey().Address())
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: addr1.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+
+	unordered := func(b client.TxBuilder) {
+		option, err := codectypes.NewAnyWithValue(&types.ExtensionOptionUnorderedNonce{})
+		s.Require().NoError(err)
+		b.(client.ExtendedTxBuilder).SetExtensionOptions(option)
+	}
+	memo := func(nonce uint64, edit func(client.TxBuilder)) string {
+		tx := newTxWith(s.T(), s.App.TxConfig(), addr1, s.Ctx.ChainID(), types.AccountNumber, []sdk.Msg{msgSend}, nonce, privKey1, edit)
+		txBytes, err := s.App.TxConfig().TxEncoder()(tx)
+		s.Require().NoError(err)
+		return s.onionMemo(txBytes)
+	}
+
+	s.SetupTest()
+	coins := sdk.Coins{sdk.NewInt64Coin("test", 1000)}
+	s.Require().NoError(s.App.BankKeeper.MintCoins(s.Ctx, minttypes.ModuleName, coins))
+	s.Require().NoError(s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, minttypes.ModuleName, addr1, coins))
+
+	// unordered txs are accepted in any order, but only once
+	for _, nonce := range []uint64{2, 0, 1} {
+		err := s.App.OnionKeeper.HandleTransferHook(s.Ctx, testPacket, testRelayer, testPacketData(memo(nonce, unordered)), s.App.TxConfig())
+		s.Require().NoError(err, "nonce %d", nonce)
+	}
+	err := s.App.OnionKeeper.HandleTransferHook(s.Ctx, testPacket, testRelayer, testPacketData(memo(1, unordered)), s.App.TxConfig())
+	s.Require().ErrorIs(err, types.ErrAnteFailed)
+	s.Require().ErrorContains(err, sdkerrors.ErrWrongSequence.Error())
+	s.Require().Equal(int64(300), s.App.BankKeeper.GetBalance(s.Ctx, addr2, "test").Amount.Int64())
+
+	nonces, err := s.App.OnionKeeper.GetUnorderedNonces(s.Ctx, addr1.String())
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), nonces.Highest)
+
+	// the strict sequence is independent of the unordered nonces, only the
+	// activity is recorded
+	seq, err := s.App.OnionKeeper.GetSequence(s.Ctx, addr1.String())
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), seq.Sequence)
+	s.Require().Equal(s.Ctx.BlockHeight(), seq.LastUsedHeight)
+
+	err = s.App.OnionKeeper.HandleTransferHook(s.Ctx, testPacket, testRelayer, testPacketData(memo(1, nil)), s.App.TxConfig())
+	s.Require().ErrorIs(err, types.ErrAnteFailed)
+	err = s.App.OnionKeeper.HandleTransferHook(s.Ctx, testPacket, testRelayer, testPacketData(memo(0, nil)), s.App.TxConfig())
+	s.Require().NoError(err)
+
+	seq, err = s.App.OnionKeeper.GetSequence(s.Ctx, addr1.String())
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), seq.Sequence)
+
+	// the nonces are part of the exported genesis
+	genState := s.App.OnionKeeper.ExportGenesis(s.Ctx)
+	s.Require().NoError(genState.Validate())
+	s.Require().Equal([]types.UnorderedNonces{nonces}, genState.UnorderedNonces)
+}
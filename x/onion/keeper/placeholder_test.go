@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"onion/x/onion/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (s *KeeperTestSuite) TestReceivedAmount() {
+	privKey := secp256k1.GenPrivKeyFromSecret([]byte("receiver"))
+	recipient := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test2")).PubKey().Address())
+
+	funds, err := types.NewReceivedFunds(testPacket, testPacketData(""))
+	s.Require().NoError(err)
+	placeholder := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(types.ReceivedAmountDenom, amount))
+	}
+	bankSend := func(amount sdk.Coins) sdk.Msg {
+		return &banktypes.MsgSend{FromAddress: testReceiver.String(), ToAddress: recipient.String(), Amount: amount}
+	}
+	receivedAmount := func(b client.TxBuilder) {
+		option, err := codectypes.NewAnyWithValue(&types.ExtensionOptionReceivedAmount{})
+		s.Require().NoError(err)
+		b.(client.ExtendedTxBuilder).SetExtensionOptions(option)
+	}
+
+	specs := map[string]struct {
+		msg      sdk.Msg
+		edit     func(client.TxBuilder)
+		expErr   error
+		expStage types.ExecutionStage
+	}{
+		"bank send": {
+			msg:  bankSend(placeholder(1)),
+			edit: receivedAmount,
+		},
+		"bank send with other coins": {
+			msg:  bankSend(placeholder(1).Add(sdk.NewInt64Coin("test", 5))),
+			edit: receivedAmount,
+		},
+		"nested in authz exec": {
+			msg: func() sdk.Msg {
+				msg := authz.NewMsgExec(testReceiver, []sdk.Msg{bankSend(placeholder(1))})
+				return &msg
+			}(),
+			edit: receivedAmount,
+		},
+		"less delivered than the minimum": {
+			msg:      bankSend(placeholder(101)),
+			edit:     receivedAmount,
+			expErr:   types.ErrAnteFailed,
+			expStage: types.EXECUTION_STAGE_ANTE,
+		},
+		"two placeholders": {
+			msg: func() sdk.Msg {
+				msg := authz.NewMsgExec(testReceiver, []sdk.Msg{bankSend(placeholder(1)), bankSend(placeholder(1))})
+				return &msg
+			}(),
+			edit:     receivedAmount,
+			expErr:   types.ErrAnteFailed,
+			expStage: types.EXECUTION_STAGE_ANTE,
+		},
+		"no placeholder": {
+			msg:      bankSend(sdk.NewCoins(sdk.NewInt64Coin("test", 5))),
+			edit:     receivedAmount,
+			expErr:   types.ErrAnteFailed,
+			expStage: types.EXECUTION_STAGE_ANTE,
+		},
+		"not opted in": {
+			msg:      bankSend(placeholder(1)),
+			expErr:   types.ErrExecuteFailed,
+			expStage: types.EXECUTION_STAGE_EXECUTE,
+		},
+	}
+	for name, spec := range specs {
+		s.Run(name, func() {
+			s.SetupTest()
+			// the voucher stands for the funds credited by the transfer
+			s.fund(testReceiver, sdk.NewCoins(funds.Coin, sdk.NewInt64Coin("test", 5)))
+
+			tx := newTxWith(s.T(), s.App.TxConfig(), testReceiver, s.Ctx.ChainID(), types.AccountNumber, []sdk.Msg{spec.msg}, 0, privKey, spec.edit)
+			txBytes, err := s.App.TxConfig().TxEncoder()(tx)
+			s.Require().NoError(err)
+
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+			err = s.App.OnionKeeper.HandleTransferHook(s.Ctx, testPacket, testRelayer, testPacketData(s.onionMemo(txBytes)), s.App.TxConfig())
+			if spec.expErr != nil {
+				s.Require().ErrorIs(err, spec.expErr)
+				s.Require().Equal(spec.expStage, s.onionExecutionEvent(s.Ctx).FailedStage)
+				s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, recipient).IsZero())
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(funds.Coin.Amount, s.App.BankKeeper.GetBalance(s.Ctx, recipient, funds.Coin.Denom).Amount)
+			s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, testReceiver, funds.Coin.Denom).IsZero())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestReceivedAmountRetry() {
+	privKey := secp256k1.GenPrivKeyFromSecret([]byte("receiver"))
+	recipient := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test2")).PubKey().Address())
+
+	funds, err := types.NewReceivedFunds(testPacket, testPacketData(""))
+	s.Require().NoError(err)
+
+	s.SetupTest()
+	// the receiver has not been credited, the tx fails and is queued
+	id := s.queueFailingTx(privKey, recipient, sdk.NewCoins(sdk.NewInt64Coin(types.ReceivedAmountDenom, 1)), func(b client.TxBuilder) {
+		option, err := codectypes.NewAnyWithValue(&types.ExtensionOptionReceivedAmount{})
+		s.Require().NoError(err)
+		b.(client.ExtendedTxBuilder).SetExtensionOptions(option)
+	})
+
+	// the retry fills in the funds of the packet that carried the tx
+	s.fund(testReceiver, sdk.NewCoins(funds.Coin))
+	event, _, err := s.App.OnionKeeper.RetryOnion(s.Ctx, testRelayer, id)
+	s.Require().NoError(err)
+	s.Require().True(event.Success)
+	s.Require().Equal(sdk.NewCoins(funds.Coin), s.App.BankKeeper.GetAllBalances(s.Ctx, recipient))
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"onion/x/onion/types"
 )
@@ -18,3 +20,90 @@ func (k Keeper) Sequence(c context.Context, req *types.QuerySequenceRequest) (*t
 	}
 	return &types.QuerySequenceResponse{Seq: seq}, nil
 }
+
+func (k Keeper) EnabledChannels(c context.Context, req *types.QueryEnabledChannelsRequest) (*types.QueryEnabledChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryEnabledChannelsResponse{Channels: k.GetParams(ctx).EnabledChannels}, nil
+}
+
+func (k Keeper) Sequences(c context.Context, req *types.QuerySequencesRequest) (*types.QuerySequencesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sequences, pageRes, err := k.GetSequencesPaginated(ctx, req.Pagination, func(seq types.OnionSequence) bool {
+		return seq.Sequence >= req.MinSequence && seq.LastUsedHeight >= req.ActiveSinceHeight
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySequencesResponse{Sequences: sequences, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Execution(c context.Context, req *types.QueryExecutionRequest) (*types.QueryExecutionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	receipt, found, err := k.GetExecutionReceipt(ctx, req.PortId, req.ChannelId, req.PacketSequence)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no execution receipt for packet %d on %s/%s", req.PacketSequence, req.PortId, req.ChannelId)
+	}
+	return &types.QueryExecutionResponse{Receipt: receipt}, nil
+}
+
+func (k Keeper) ExecutionsBySigner(c context.Context, req *types.QueryExecutionsBySignerRequest) (*types.QueryExecutionsBySignerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Signer); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	receipts, pageRes, err := k.GetExecutionsBySignerPaginated(ctx, req.Signer, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryExecutionsBySignerResponse{Receipts: receipts, Pagination: pageRes}, nil
+}
+
+func (k Keeper) QueuedTx(c context.Context, req *types.QueryQueuedTxRequest) (*types.QueryQueuedTxResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	queued, found, err := k.GetQueuedTx(ctx, req.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no queued onion tx %d", req.Id)
+	}
+	return &types.QueryQueuedTxResponse{QueuedTx: queued}, nil
+}
+
+func (k Keeper) QueuedTxsBySigner(c context.Context, req *types.QueryQueuedTxsBySignerRequest) (*types.QueryQueuedTxsBySignerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Signer); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	queuedTxs, pageRes, err := k.GetQueuedTxsBySignerPaginated(ctx, req.Signer, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryQueuedTxsBySignerResponse{QueuedTxs: queuedTxs, Pagination: pageRes}, nil
+}
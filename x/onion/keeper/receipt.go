@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/gogoproto/proto"
+
+	"onion/x/onion/types"
+)
+
+// GetExecutionReceipt returns the execution receipt of a received packet.
+func (k Keeper) GetExecutionReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) (types.ExecutionReceipt, bool, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, []byte(types.OnionExecutionReceiptPrefix))
+	bz := prefixStore.Get(types.ExecutionReceiptKey(portID, channelID, sequence))
+	if bz == nil {
+		return types.ExecutionReceipt{}, false, nil
+	}
+	receipt := types.ExecutionReceipt{}
+	if err := proto.Unmarshal(bz, &receipt); err != nil {
+		return types.ExecutionReceipt{}, false, err
+	}
+	return receipt, true, nil
+}
+
+// GetAllExecutionReceipts returns all stored execution receipts.
+func (k Keeper) GetAllExecutionReceipts(ctx sdk.Context) ([]types.ExecutionReceipt, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.OnionExecutionReceiptPrefix))
+	defer iterator.Close()
+
+	receipts := []types.ExecutionReceipt{}
+	for ; iterator.Valid(); iterator.Next() {
+		receipt := types.ExecutionReceipt{}
+		if err := proto.Unmarshal(iterator.Value(), &receipt); err != nil {
+			return nil, err
+		}
+		receipts = append(receipts, receipt)
+	}
+	return receipts, nil
+}
+
+// SetExecutionReceipt stores an execution receipt and indexes it by signer
+// and by height.
+func (k Keeper) SetExecutionReceipt(ctx sdk.Context, receipt types.ExecutionReceipt) error {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	key := types.ExecutionReceiptKey(receipt.PortId, receipt.ChannelId, receipt.PacketSequence)
+
+	bz, err := proto.Marshal(&receipt)
+	if err != nil {
+		return err
+	}
+	prefix.NewStore(store, []byte(types.OnionExecutionReceiptPrefix)).Set(key, bz)
+
+	bySigner := prefix.NewStore(store, []byte(types.OnionExecutionsBySignerPrefix))
+	for _, signer := range receipt.Signers {
+		bySigner.Set(append([]byte(signer+"/"), key...), []byte{})
+	}
+
+	byHeight := prefix.NewStore(store, []byte(types.OnionExecutionsByHeightPrefix))
+	byHeight.Set(append(sdk.Uint64ToBigEndian(uint64(receipt.Height)), key...), []byte{})
+	return nil
+}
+
+// GetExecutionsBySignerPaginated returns a page of the execution receipts of
+// onion txs signed by signer.
+func (k Keeper) GetExecutionsBySignerPaginated(ctx sdk.Context, signer string, pageReq *query.PageRequest) ([]types.ExecutionReceipt, *query.PageResponse, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	receiptStore := prefix.NewStore(store, []byte(types.OnionExecutionReceiptPrefix))
+	signerStore := prefix.NewStore(store, []byte(types.OnionExecutionsBySignerPrefix+signer+"/"))
+
+	receipts := []types.ExecutionReceipt{}
+	pageRes, err := query.Paginate(signerStore, pageReq, func(key, _ []byte) error {
+		receipt := types.ExecutionReceipt{}
+		if err := proto.Unmarshal(receiptStore.Get(key), &receipt); err != nil {
+			return err
+		}
+		receipts = append(receipts, receipt)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return receipts, pageRes, nil
+}
+
+// PruneExecutionReceipts deletes the execution receipts that are older than
+// the receipt retention of the params, all of them if it is zero.
+func (k Keeper) PruneExecutionReceipts(ctx sdk.Context) error {
+	retention := k.GetParams(ctx).ReceiptRetentionBlocks
+	if ctx.BlockHeight() < 0 || uint64(ctx.BlockHeight()) < retention {
+		return nil
+	}
+	cutoff := uint64(ctx.BlockHeight()) - retention
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	receiptStore := prefix.NewStore(store, []byte(types.OnionExecutionReceiptPrefix))
+	bySigner := prefix.NewStore(store, []byte(types.OnionExecutionsBySignerPrefix))
+	byHeight := prefix.NewStore(store, []byte(types.OnionExecutionsByHeightPrefix))
+
+	iterator := byHeight.Iterator(nil, sdk.Uint64ToBigEndian(cutoff+1))
+	var pruned [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		pruned = append(pruned, iterator.Key())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, heightKey := range pruned {
+		key := heightKey[8:]
+		if bz := receiptStore.Get(key); bz != nil {
+			receipt := types.ExecutionReceipt{}
+			if err := proto.Unmarshal(bz, &receipt); err != nil {
+				return err
+			}
+			for _, signer := range receipt.Signers {
+				bySigner.Delete(append([]byte(signer+"/"), key...))
+			}
+			receiptStore.Delete(key)
+		}
+		byHeight.Delete(heightKey)
+	}
+	return nil
+}
+
+// newExecutionReceipt builds the receipt of an onion execution from its event
+// and the results of the tx messages.
+func newExecutionReceipt(ctx sdk.Context, event types.EventOnionExecution, results []sdk.Result) types.ExecutionReceipt {
+	receipt := types.ExecutionReceipt{
+		PortId:         event.DestinationPort,
+		ChannelId:      event.DestinationChannel,
+		PacketSequence: event.PacketSequence,
+		Height:         ctx.BlockHeight(),
+		Success:        event.Success,
+		FailedStage:    event.FailedStage,
+		ErrorCode:      event.ErrorCode,
+		Error:          event.Error,
+		Signers:        event.Signers,
+		TxHash:         event.TxHash,
+		GasUsed:        event.GasUsed,
+	}
+	for _, result := range results {
+		receipt.MsgResponses = append(receipt.MsgResponses, result.MsgResponses...)
+	}
+	return receipt
+}
@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"onion/x/onion/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *KeeperTestSuite) TestExecutionReceipts() {
+	privKey1 := secp256k1.GenPrivKeyFromSecret([]byte("test1"))
+	addr1 := sdk.AccAddress(privKey1.PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test2")).PubKey().Address())
+
+	s.SetupTest()
+	coins := sdk.Coins{sdk.NewInt64Coin("test", 100)}
+	s.Require().NoError(s.App.BankKeeper.MintCoins(s.Ctx, minttypes.ModuleName, coins))
+	s.Require().NoError(s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, minttypes.ModuleName, addr1, coins))
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: addr1.String(),
+		ToAddress:   addr2.String(),
+		Amount:      coins,
+	}
+	handle := func(packetSeq, nonce uint64) error {
+		tx := newTx(s.T(), s.App.TxConfig(), addr1, s.Ctx.ChainID(), types.AccountNumber, []sdk.Msg{msgSend}, nonce, privKey1)
+		txBytes, err := s.App.TxConfig().TxEncoder()(tx)
+		s.Require().NoError(err)
+
+		packet := testPacket
+		packet.Sequence = packetSeq
+		return s.App.OnionKeeper.HandleTransferHook(s.Ctx, packet, testRelayer, testPacketData(s.onionMemo(txBytes)), s.App.TxConfig())
+	}
+
+	// the first transfer succeeds, the second one lacks funds
+	s.Ctx = s.Ctx.WithBlockHeight(10)
+	s.Require().NoError(handle(1, 0))
+	s.Ctx = s.Ctx.WithBlockHeight(11)
+	s.Require().ErrorIs(handle(2, 1), types.ErrExecuteFailed)
+
+	res, err := s.App.OnionKeeper.Execution(s.Ctx, &types.QueryExecutionRequest{
+		PortId:         testPacket.DestinationPort,
+		ChannelId:      testPacket.DestinationChannel,
+		PacketSequence: 1,
+	})
+	s.Require().NoError(err)
+	receipt := res.Receipt
+	s.Require().True(receipt.Success)
+	s.Require().Equal(int64(10), receipt.Height)
+	s.Require().Equal([]string{addr1.String()}, receipt.Signers)
+	s.Require().NotEmpty(receipt.TxHash)
+	s.Require().Positive(receipt.GasUsed)
+	s.Require().Zero(receipt.ErrorCode)
+	s.Require().Len(receipt.MsgResponses, 1)
+	s.Require().Equal("/cosmos.bank.v1beta1.MsgSendResponse", receipt.MsgResponses[0].TypeUrl)
+
+	res, err = s.App.OnionKeeper.Execution(s.Ctx, &types.QueryExecutionRequest{
+		PortId:         testPacket.DestinationPort,
+		ChannelId:      testPacket.DestinationChannel,
+		PacketSequence: 2,
+	})
+	s.Require().NoError(err)
+	receipt = res.Receipt
+	s.Require().False(receipt.Success)
+	s.Require().Equal(types.EXECUTION_STAGE_EXECUTE, receipt.FailedStage)
+	s.Require().Equal(types.ErrExecuteFailed.ABCICode(), receipt.ErrorCode)
+	s.Require().NotEmpty(receipt.Error)
+	s.Require().Empty(receipt.MsgResponses)
+
+	_, err = s.App.OnionKeeper.Execution(s.Ctx, &types.QueryExecutionRequest{
+		PortId:         testPacket.DestinationPort,
+		ChannelId:      testPacket.DestinationChannel,
+		PacketSequence: 3,
+	})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+
+	bySigner, err := s.App.OnionKeeper.ExecutionsBySigner(s.Ctx, &types.QueryExecutionsBySignerRequest{
+		Signer:     addr1.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(bySigner.Receipts, 1)
+	s.Require().Equal(uint64(1), bySigner.Receipts[0].PacketSequence)
+	s.Require().Equal(uint64(2), bySigner.Pagination.Total)
+
+	bySigner, err = s.App.OnionKeeper.ExecutionsBySigner(s.Ctx, &types.QueryExecutionsBySignerRequest{Signer: addr2.String()})
+	s.Require().NoError(err)
+	s.Require().Empty(bySigner.Receipts)
+
+	_, err = s.App.OnionKeeper.ExecutionsBySigner(s.Ctx, &types.QueryExecutionsBySignerRequest{Signer: "invalid"})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *KeeperTestSuite) TestPruneExecutionReceipts() {
+	s.SetupTest()
+	signer := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test1")).PubKey().Address()).String()
+
+	params := types.DefaultParams()
+	params.ReceiptRetentionBlocks = 10
+	s.Require().NoError(s.App.OnionKeeper.SetParams(s.Ctx, params))
+
+	for seq, height := range map[uint64]int64{1: 5, 2: 6, 3: 12} {
+		s.Require().NoError(s.App.OnionKeeper.SetExecutionReceipt(s.Ctx, types.ExecutionReceipt{
+			PortId:         "transfer",
+			ChannelId:      "channel-0",
+			PacketSequence: seq,
+			Height:         height,
+			Signers:        []string{signer},
+		}))
+	}
+	receiptExists := func(seq uint64) bool {
+		_, found, err := s.App.OnionKeeper.GetExecutionReceipt(s.Ctx, "transfer", "channel-0", seq)
+		s.Require().NoError(err)
+		return found
+	}
+	signerReceipts := func() int {
+		receipts, _, err := s.App.OnionKeeper.GetExecutionsBySignerPaginated(s.Ctx, signer, nil)
+		s.Require().NoError(err)
+		return len(receipts)
+	}
+
+	// nothing to prune before the retention of the oldest receipt passed
+	s.Ctx = s.Ctx.WithBlockHeight(14)
+	s.Require().NoError(s.App.OnionKeeper.PruneExecutionReceipts(s.Ctx))
+	s.Require().Equal(3, signerReceipts())
+
+	s.Ctx = s.Ctx.WithBlockHeight(16)
+	s.Require().NoError(s.App.OnionKeeper.PruneExecutionReceipts(s.Ctx))
+	s.Require().False(receiptExists(1))
+	s.Require().False(receiptExists(2))
+	s.Require().True(receiptExists(3))
+	s.Require().Equal(1, signerReceipts())
+
+	// disabling receipts prunes the remaining ones
+	params.ReceiptRetentionBlocks = 0
+	s.Require().NoError(s.App.OnionKeeper.SetParams(s.Ctx, params))
+	s.Require().NoError(s.App.OnionKeeper.PruneExecutionReceipts(s.Ctx))
+	s.Require().False(receiptExists(3))
+	s.Require().Zero(signerReceipts())
+}
+
+func (s *KeeperTestSuite) TestExecutionReceiptsDisabled() {
+	s.SetupTest()
+	params := types.DefaultParams()
+	params.ReceiptRetentionBlocks = 0
+	s.Require().NoError(s.App.OnionKeeper.SetParams(s.Ctx, params))
+
+	err := s.App.OnionKeeper.HandleTransferHook(s.Ctx, testPacket, testRelayer, testPacketData(`{"onion":{"tx":"not base64!","version":1}}`), s.App.TxConfig())
+	s.Require().ErrorIs(err, types.ErrInvalidMemo)
+
+	_, found, err := s.App.OnionKeeper.GetExecutionReceipt(s.Ctx, testPacket.DestinationPort, testPacket.DestinationChannel, testPacket.Sequence)
+	s.Require().NoError(err)
+	s.Require().False(found)
+}
@@ -0,0 +1,208 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"onion/x/onion/types"
+)
+
+// GetRecovery returns the recovery instruction of an onion tx, set through
+// ExtensionOptionRecovery, or nil if it has none.
+func GetRecovery(tx sdk.Tx) *types.ExtensionOptionRecovery {
+	extTx, ok := tx.(ante.HasExtensionOptionsTx)
+	if !ok {
+		return nil
+	}
+	for _, option := range extTx.GetExtensionOptions() {
+		if recovery, ok := option.GetCachedValue().(*types.ExtensionOptionRecovery); ok {
+			return recovery
+		}
+	}
+	return nil
+}
+
+// recoverFunds runs the recovery instruction of a failed onion tx carried by
+// packet and reports whether the funds were moved. An EventOnionRecovery is
+// emitted when the tx has a recovery instruction. anteDone tells that the
+// ante state of the tx was written, its onion sequences are used then.
+func (k Keeper) recoverFunds(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData, txBytes []byte, txDecoder sdk.TxDecoder, anteDone bool) bool {
+	tx, err := txDecoder(txBytes)
+	if err != nil || validateDecodedTx(tx) != nil {
+		return false
+	}
+	recovery := GetRecovery(tx)
+	if recovery == nil {
+		return false
+	}
+
+	event := types.EventOnionRecovery{
+		DestinationPort:    packet.DestinationPort,
+		DestinationChannel: packet.DestinationChannel,
+		PacketSequence:     packet.Sequence,
+		Address:            recovery.Address,
+		ReturnReceiver:     recovery.ReturnReceiver,
+		TxHash:             txHash(txBytes),
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.runRecovery(cacheCtx, &event, packet, data, tx, recovery, anteDone); err != nil {
+		event.Error = err.Error()
+	} else {
+		write()
+		event.Success = true
+	}
+
+	if emitErr := ctx.EventManager().EmitTypedEvent(&event); emitErr != nil {
+		k.Logger().Error("failed to emit onion recovery event", "error", emitErr)
+	}
+	return event.Success
+}
+
+// runRecovery moves the funds packet carrying data delivered to its receiver
+// as recovery instructs. The receiver must have signed tx with its current
+// onion sequence, or an unused unordered nonce, which is used up unless
+// anteDone, so the instruction of an old memo cannot be replayed with a new
+// transfer.
+func (k Keeper) runRecovery(ctx sdk.Context, event *types.EventOnionRecovery, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData, tx sdk.Tx, recovery *types.ExtensionOptionRecovery, anteDone bool) error {
+	if err := recovery.Validate(); err != nil {
+		return err
+	}
+
+	event.Receiver = data.Receiver
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid packet receiver")
+	}
+	sig, err := k.verifySignerSignature(ctx, tx, receiver)
+	if err != nil {
+		return err
+	}
+	if !anteDone {
+		if err := k.useRecoverySequence(ctx, tx, receiver, sig); err != nil {
+			return err
+		}
+	}
+
+	// never more than the packet delivered, the receiver may have spent part
+	// of it in the meantime within the same block
+	coin, err := types.ReceivedCoin(packet, data)
+	if err != nil {
+		return err
+	}
+	if spendable := k.bankKeeper.SpendableCoins(ctx, receiver).AmountOf(coin.Denom); spendable.LT(coin.Amount) {
+		coin.Amount = spendable
+	}
+	event.Amount = coin
+	if !coin.IsPositive() {
+		return fmt.Errorf("no %s left to recover", coin.Denom)
+	}
+
+	if recovery.Address != "" {
+		address, err := sdk.AccAddressFromBech32(recovery.Address)
+		if err != nil {
+			return err
+		}
+		return k.bankKeeper.SendCoins(ctx, receiver, address, sdk.NewCoins(coin))
+	}
+
+	// the return transfer goes back over the channel the packet arrived on
+	timeout := recovery.ReturnTimeout
+	if timeout == 0 {
+		timeout = transfertypes.DefaultRelativePacketTimeoutTimestamp
+	}
+	msg := transfertypes.NewMsgTransfer(
+		packet.DestinationPort, packet.DestinationChannel, coin, receiver.String(), recovery.ReturnReceiver,
+		clienttypes.ZeroHeight(), uint64(ctx.BlockTime().UnixNano())+timeout, "",
+	)
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "no message handler found for %q", sdk.MsgTypeURL(msg))
+	}
+	result, err := handler(ctx, msg)
+	if err != nil {
+		return err
+	}
+	for _, ev := range result.Events {
+		ctx.EventManager().EmitEvent(sdk.Event(ev))
+	}
+	return nil
+}
+
+// useRecoverySequence checks that sig of signer claims its current onion
+// sequence, or an unused nonce for unordered txs, and uses it.
+func (k Keeper) useRecoverySequence(ctx sdk.Context, tx sdk.Tx, signer sdk.AccAddress, sig signing.SignatureV2) error {
+	if IsUnorderedTx(tx) {
+		nonces, err := k.GetUnorderedNonces(ctx, signer.String())
+		if err != nil {
+			return err
+		}
+		if err := nonces.Use(sig.Sequence); err != nil {
+			return err
+		}
+		return k.SetUnorderedNonces(ctx, nonces)
+	}
+
+	seq, err := k.GetSequence(ctx, signer.String())
+	if err != nil {
+		seq = types.OnionSequence{Address: signer.String()}
+	}
+	if sig.Sequence != seq.Sequence {
+		return errorsmod.Wrapf(sdkerrors.ErrWrongSequence,
+			"onion sequence mismatch, expected %d, got %d", seq.Sequence, sig.Sequence)
+	}
+	seq.Sequence++
+	seq.LastUsedHeight = ctx.BlockHeight()
+	return k.SetSequence(ctx, seq)
+}
+
+// verifySignerSignature verifies the signature of signer over tx and returns
+// it, the onion sequence it claims is not checked.
+func (k Keeper) verifySignerSignature(ctx sdk.Context, tx sdk.Tx, signer sdk.AccAddress) (signing.SignatureV2, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return signing.SignatureV2{}, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid tx type")
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+	pubKeys, err := sigTx.GetPubKeys()
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+	if len(sigs) != len(signers) || len(pubKeys) != len(signers) {
+		return signing.SignatureV2{}, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "invalid number of signatures")
+	}
+
+	for i, addr := range signers {
+		if !bytes.Equal(addr, signer) {
+			continue
+		}
+		pubKey := pubKeys[i]
+		if pubKey == nil {
+			if acc := k.accountKeeper.GetAccount(ctx, signer); acc != nil {
+				pubKey = acc.GetPubKey()
+			}
+		}
+		if pubKey == nil || !bytes.Equal(pubKey.Address(), signer) {
+			return signing.SignatureV2{}, errorsmod.Wrapf(sdkerrors.ErrInvalidPubKey, "no pubkey for signer %s", signer)
+		}
+		return sigs[i], k.verifySignature(ctx, tx, signer.String(), pubKey, sigs[i])
+	}
+	return signing.SignatureV2{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "packet receiver %s did not sign the onion tx", signer)
+}
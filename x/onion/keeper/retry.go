@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/gogoproto/proto"
+
+	"onion/x/onion/types"
+)
+
+// GetQueuedTx returns the onion tx queued for retries under id.
+func (k Keeper) GetQueuedTx(ctx sdk.Context, id uint64) (types.QueuedOnionTx, bool, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := prefix.NewStore(store, []byte(types.OnionQueuedTxPrefix)).Get(sdk.Uint64ToBigEndian(id))
+	if bz == nil {
+		return types.QueuedOnionTx{}, false, nil
+	}
+	queued := types.QueuedOnionTx{}
+	if err := proto.Unmarshal(bz, &queued); err != nil {
+		return types.QueuedOnionTx{}, false, err
+	}
+	return queued, true, nil
+}
+
+// GetAllQueuedTxs returns all onion txs in the retry queue.
+func (k Keeper) GetAllQueuedTxs(ctx sdk.Context) ([]types.QueuedOnionTx, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.OnionQueuedTxPrefix))
+	defer iterator.Close()
+
+	queuedTxs := []types.QueuedOnionTx{}
+	for ; iterator.Valid(); iterator.Next() {
+		queued := types.QueuedOnionTx{}
+		if err := proto.Unmarshal(iterator.Value(), &queued); err != nil {
+			return nil, err
+		}
+		queuedTxs = append(queuedTxs, queued)
+	}
+	return queuedTxs, nil
+}
+
+// SetQueuedTx stores a queued onion tx and indexes it by signer and by
+// expiry height.
+func (k Keeper) SetQueuedTx(ctx sdk.Context, queued types.QueuedOnionTx) error {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	key := sdk.Uint64ToBigEndian(queued.Id)
+
+	bz, err := proto.Marshal(&queued)
+	if err != nil {
+		return err
+	}
+	prefix.NewStore(store, []byte(types.OnionQueuedTxPrefix)).Set(key, bz)
+
+	bySigner := prefix.NewStore(store, []byte(types.OnionQueueBySignerPrefix))
+	for _, signer := range queued.Signers {
+		bySigner.Set(append([]byte(signer+"/"), key...), []byte{})
+	}
+
+	byExpiry := prefix.NewStore(store, []byte(types.OnionQueueByExpiryPrefix))
+	byExpiry.Set(append(sdk.Uint64ToBigEndian(uint64(queued.ExpiryHeight)), key...), []byte{})
+	return nil
+}
+
+// DeleteQueuedTx removes a queued onion tx and its index entries.
+func (k Keeper) DeleteQueuedTx(ctx sdk.Context, queued types.QueuedOnionTx) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	key := sdk.Uint64ToBigEndian(queued.Id)
+
+	prefix.NewStore(store, []byte(types.OnionQueuedTxPrefix)).Delete(key)
+
+	bySigner := prefix.NewStore(store, []byte(types.OnionQueueBySignerPrefix))
+	for _, signer := range queued.Signers {
+		bySigner.Delete(append([]byte(signer+"/"), key...))
+	}
+
+	byExpiry := prefix.NewStore(store, []byte(types.OnionQueueByExpiryPrefix))
+	byExpiry.Delete(append(sdk.Uint64ToBigEndian(uint64(queued.ExpiryHeight)), key...))
+}
+
+// GetQueuedTxsBySignerPaginated returns a page of the queued onion txs
+// signed by signer.
+func (k Keeper) GetQueuedTxsBySignerPaginated(ctx sdk.Context, signer string, pageReq *query.PageRequest) ([]types.QueuedOnionTx, *query.PageResponse, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	queuedStore := prefix.NewStore(store, []byte(types.OnionQueuedTxPrefix))
+	signerStore := prefix.NewStore(store, []byte(types.OnionQueueBySignerPrefix+signer+"/"))
+
+	queuedTxs := []types.QueuedOnionTx{}
+	pageRes, err := query.Paginate(signerStore, pageReq, func(key, _ []byte) error {
+		queued := types.QueuedOnionTx{}
+		if err := proto.Unmarshal(queuedStore.Get(key), &queued); err != nil {
+			return err
+		}
+		queuedTxs = append(queuedTxs, queued)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return queuedTxs, pageRes, nil
+}
+
+// PruneRetryQueue deletes the queued onion txs whose expiry height is
+// reached, they cannot be retried after this block.
+func (k Keeper) PruneRetryQueue(ctx sdk.Context) error {
+	if ctx.BlockHeight() < 0 {
+		return nil
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	queuedStore := prefix.NewStore(store, []byte(types.OnionQueuedTxPrefix))
+	byExpiry := prefix.NewStore(store, []byte(types.OnionQueueByExpiryPrefix))
+
+	iterator := byExpiry.Iterator(nil, sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())+1))
+	var expired []types.QueuedOnionTx
+	for ; iterator.Valid(); iterator.Next() {
+		queued := types.QueuedOnionTx{}
+		if err := proto.Unmarshal(queuedStore.Get(iterator.Key()[8:]), &queued); err != nil {
+			_ = iterator.Close()
+			return err
+		}
+		expired = append(expired, queued)
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, queued := range expired {
+		k.DeleteQueuedTx(ctx, queued)
+	}
+	return nil
+}
+
+// HasRetry reports whether an onion tx opted in to the retry queue, see
+// ExtensionOptionRetry.
+func HasRetry(tx sdk.Tx) bool {
+	extTx, ok := tx.(ante.HasExtensionOptionsTx)
+	if !ok {
+		return false
+	}
+	for _, option := range extTx.GetExtensionOptions() {
+		if _, ok := option.GetCachedValue().(*types.ExtensionOptionRetry); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// queueTx adds an onion tx that failed during execution to the retry queue
+// with its execution error execErr and returns its id, or zero when the tx
+// did not opt in with ExtensionOptionRetry. It expires
+// Params.retry_queue_blocks from now or at the timeout height of the tx,
+// whichever comes first.
+func (k Keeper) queueTx(ctx sdk.Context, event types.EventOnionExecution, memo types.ParsedMemo, txDecoder sdk.TxDecoder, retryQueueBlocks uint64, execErr error) (uint64, error) {
+	tx, err := txDecoder(memo.TxBytes)
+	if err != nil {
+		return 0, err
+	}
+	if !HasRetry(tx) {
+		return 0, nil
+	}
+	expiryHeight := ctx.BlockHeight() + int64(retryQueueBlocks)
+	if timeoutTx, ok := tx.(sdk.TxWithTimeoutHeight); ok {
+		if timeoutHeight := timeoutTx.GetTimeoutHeight(); timeoutHeight > 0 && int64(timeoutHeight) < expiryHeight {
+			expiryHeight = int64(timeoutHeight)
+		}
+	}
+
+	queued := types.QueuedOnionTx{
+		Id:             k.nextQueuedTxID(ctx),
+		TxBytes:        memo.TxBytes,
+		Deadline:       memo.Deadline,
+		Signers:        event.Signers,
+		TxHash:         event.TxHash,
+		PortId:         event.DestinationPort,
+		ChannelId:      event.DestinationChannel,
+		PacketSequence: event.PacketSequence,
+		Height:         ctx.BlockHeight(),
+		ExpiryHeight:   expiryHeight,
+		Error:          execErr.Error(),
+		Received:       event.Received,
+	}
+	if err := k.SetQueuedTx(ctx, queued); err != nil {
+		return 0, err
+	}
+	return queued.Id, nil
+}
+
+// GetNextQueuedTxID returns the id the next queued onion tx gets, ids start
+// at 1.
+func (k Keeper) GetNextQueuedTxID(ctx sdk.Context) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	if bz := store.Get([]byte(types.OnionQueueNextIDKey)); bz != nil {
+		return sdk.BigEndianToUint64(bz)
+	}
+	return 1
+}
+
+// SetNextQueuedTxID sets the id the next queued onion tx gets.
+func (k Keeper) SetNextQueuedTxID(ctx sdk.Context, id uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set([]byte(types.OnionQueueNextIDKey), sdk.Uint64ToBigEndian(id))
+}
+
+// nextQueuedTxID returns the next id of the retry queue and advances it.
+func (k Keeper) nextQueuedTxID(ctx sdk.Context) uint64 {
+	id := k.GetNextQueuedTxID(ctx)
+	k.SetNextQueuedTxID(ctx, id+1)
+	return id
+}
+
+// RetryOnion executes the messages of a queued onion tx again, the sender
+// pays the gas. The ante checks are not repeated, the fee was paid to the
+// relayer of the first attempt and the onion sequences were used then. The
+// tx is removed from the queue when it succeeds or fails before execution,
+// e.g. because it expired meanwhile, and stays queued when it fails during
+// execution again. An EventOnionExecution is emitted for every retry and
+// returned with the outcome, an error is only returned when the tx cannot be
+// retried.
+func (k Keeper) RetryOnion(ctx sdk.Context, sender sdk.AccAddress, id uint64) (types.EventOnionExecution, []sdk.Result, error) {
+	queued, found, err := k.GetQueuedTx(ctx, id)
+	if err != nil {
+		return types.EventOnionExecution{}, nil, err
+	}
+	if !found || ctx.BlockHeight() > queued.ExpiryHeight {
+		return types.EventOnionExecution{}, nil, errorsmod.Wrapf(types.ErrQueuedTxNotFound, "id %d", id)
+	}
+
+	event := types.EventOnionExecution{
+		DestinationPort:    queued.PortId,
+		DestinationChannel: queued.ChannelId,
+		PacketSequence:     queued.PacketSequence,
+		Submitter:          sender.String(),
+		RetryId:            id,
+	}
+	if queued.Received != nil {
+		event.Received = queued.Received
+		ctx = types.WithReceivedFunds(ctx, *queued.Received)
+	}
+	memo := types.ParsedMemo{TxBytes: queued.TxBytes, Deadline: queued.Deadline}
+	results, execErr := k.executeTx(ctx, &event, sender, memo, k.txDecoder, true)
+	if execErr != nil && event.FailedStage == types.EXECUTION_STAGE_EXECUTE {
+		queued.Retries++
+		queued.Error = execErr.Error()
+		err = k.SetQueuedTx(ctx, queued)
+		event.QueuedId = id
+	} else {
+		k.DeleteQueuedTx(ctx, queued)
+	}
+	k.emitExecutionEvent(ctx, &event, execErr)
+	return event, results, err
+}
@@ -0,0 +1,244 @@
+package keeper_test
+
+import (
+	"time"
+
+	"onion/x/onion/keeper"
+	"onion/x/onion/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// testRetryQueueBlocks is the retry_queue_blocks param enabling the retry
+// queue in tests.
+const testRetryQueueBlocks = 100
+
+// enableRetryQueue sets the retry_queue_blocks param to testRetryQueueBlocks.
+func (s *KeeperTestSuite) enableRetryQueue() {
+	params := s.App.OnionKeeper.GetParams(s.Ctx)
+	params.RetryQueueBlocks = testRetryQueueBlocks
+	s.Require().NoError(s.App.OnionKeeper.SetParams(s.Ctx, params))
+}
+
+// withRetry adds the retry queue opt-in to the onion tx being built.
+func (s *KeeperTestSuite) withRetry(edit func(client.TxBuilder)) func(client.TxBuilder) {
+	return func(b client.TxBuilder) {
+		if edit != nil {
+			edit(b)
+		}
+		extTx := b.(client.ExtendedTxBuilder)
+		option, err := codectypes.NewAnyWithValue(&types.ExtensionOptionRetry{})
+		s.Require().NoError(err)
+		extTx.SetExtensionOptions(append(b.GetTx().(ante.HasExtensionOptionsTx).GetExtensionOptions(), option)...)
+	}
+}
+
+// queueFailingTx enables the retry queue and delivers an onion tx of privKey
+// opting in to it and sending coins it does not have, so it fails during
+// execution, and returns its queued id.
+func (s *KeeperTestSuite) queueFailingTx(privKey *secp256k1.PrivKey, to sdk.AccAddress, coins sdk.Coins, edit func(client.TxBuilder)) uint64 {
+	s.enableRetryQueue()
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+	msg := &banktypes.MsgSend{FromAddress: addr.String(), ToAddress: to.String(), Amount: coins}
+	tx := newTxWith(s.T(), s.App.TxConfig(), addr, s.Ctx.ChainID(), types.AccountNumber, []sdk.Msg{msg}, 0, privKey, s.withRetry(edit))
+	txBytes, err := s.App.TxConfig().TxEncoder()(tx)
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	err = s.App.OnionKeeper.HandleTransferHook(s.Ctx, testPacket, testRelayer, testPacketData(s.onionMemo(txBytes)), s.App.TxConfig())
+	s.Require().ErrorIs(err, types.ErrExecuteFailed)
+	event := s.onionExecutionEvent(s.Ctx)
+	s.Require().NotZero(event.QueuedId)
+	return event.QueuedId
+}
+
+func (s *KeeperTestSuite) TestRetryOnion() {
+	privKey := secp256k1.GenPrivKeyFromSecret([]byte("test1"))
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test2")).PubKey().Address())
+	retrier := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("retrier")).PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 100))
+
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockHeight(10)
+	id := s.queueFailingTx(privKey, recipient, coins, nil)
+
+	queued, found, err := s.App.OnionKeeper.GetQueuedTx(s.Ctx, id)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal([]string{addr.String()}, queued.Signers)
+	s.Require().Equal(testPacket.DestinationChannel, queued.ChannelId)
+	s.Require().Equal(int64(10+testRetryQueueBlocks), queued.ExpiryHeight)
+	s.Require().NotEmpty(queued.Error)
+	s.Require().NotNil(queued.Received)
+	s.Require().Equal(testReceiver.String(), queued.Received.Receiver)
+
+	res, err := s.App.OnionKeeper.QueuedTxsBySigner(s.Ctx, &types.QueryQueuedTxsBySignerRequest{Signer: addr.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.QueuedTxs, 1)
+
+	ms := keeper.NewMsgServerImpl(s.App.OnionKeeper)
+
+	// still out of funds, the tx stays queued
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	retryRes, err := ms.RetryOnion(s.Ctx, types.NewMsgRetryOnion(retrier.String(), id))
+	s.Require().NoError(err)
+	s.Require().False(retryRes.Success)
+	s.Require().Equal(types.EXECUTION_STAGE_EXECUTE, retryRes.FailedStage)
+	event := s.onionExecutionEvent(s.Ctx)
+	s.Require().Equal(id, event.RetryId)
+	s.Require().Equal(id, event.QueuedId)
+	s.Require().Equal(retrier.String(), event.Submitter)
+	s.Require().Equal(queued.Received, event.Received)
+	queued, found, err = s.App.OnionKeeper.GetQueuedTx(s.Ctx, id)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(uint32(1), queued.Retries)
+
+	// funded, the retry succeeds and dequeues the tx
+	s.fund(addr, coins)
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	retryRes, err = ms.RetryOnion(s.Ctx, types.NewMsgRetryOnion(retrier.String(), id))
+	s.Require().NoError(err)
+	s.Require().True(retryRes.Success)
+	s.Require().Len(retryRes.MsgResponses, 1)
+	s.Require().True(s.onionExecutionEvent(s.Ctx).Success)
+	s.Require().Equal(coins, s.App.BankKeeper.GetAllBalances(s.Ctx, recipient))
+
+	seq, err := s.App.OnionKeeper.GetSequence(s.Ctx, addr.String())
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), seq.Sequence)
+
+	_, found, err = s.App.OnionKeeper.GetQueuedTx(s.Ctx, id)
+	s.Require().NoError(err)
+	s.Require().False(found)
+	res, err = s.App.OnionKeeper.QueuedTxsBySigner(s.Ctx, &types.QueryQueuedTxsBySignerRequest{Signer: addr.String()})
+	s.Require().NoError(err)
+	s.Require().Empty(res.QueuedTxs)
+
+	_, err = ms.RetryOnion(s.Ctx, types.NewMsgRetryOnion(retrier.String(), id))
+	s.Require().ErrorIs(err, types.ErrQueuedTxNotFound)
+}
+
+func (s *KeeperTestSuite) TestRetryOnionDropsInvalidTx() {
+	privKey := secp256k1.GenPrivKeyFromSecret([]byte("test1"))
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test2")).PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 100))
+
+	s.SetupTest()
+	blockTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	s.Ctx = s.Ctx.WithBlockTime(blockTime)
+	id := s.queueFailingTx(privKey, recipient, coins, func(b client.TxBuilder) {
+		option, err := codectypes.NewAnyWithValue(&types.ExtensionOptionDeadline{Deadline: blockTime.Add(time.Hour)})
+		s.Require().NoError(err)
+		b.(client.ExtendedTxBuilder).SetExtensionOptions(option)
+	})
+
+	// the signed deadline of the queued tx passes meanwhile
+	s.Ctx = s.Ctx.WithBlockTime(blockTime.Add(2 * time.Hour))
+	s.fund(addr, coins)
+
+	retryRes, err := keeper.NewMsgServerImpl(s.App.OnionKeeper).RetryOnion(s.Ctx, types.NewMsgRetryOnion(recipient.String(), id))
+	s.Require().NoError(err)
+	s.Require().False(retryRes.Success)
+	s.Require().Equal(types.EXECUTION_STAGE_ANTE, retryRes.FailedStage)
+
+	_, found, err := s.App.OnionKeeper.GetQueuedTx(s.Ctx, id)
+	s.Require().NoError(err)
+	s.Require().False(found)
+	s.Require().Equal(coins, s.App.BankKeeper.GetAllBalances(s.Ctx, addr))
+}
+
+func (s *KeeperTestSuite) TestRetryQueueNotUsed() {
+	privKey := secp256k1.GenPrivKeyFromSecret([]byte("test1"))
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test2")).PubKey().Address())
+	msg := &banktypes.MsgSend{FromAddress: addr.String(), ToAddress: recipient.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 100))}
+
+	specs := map[string]struct {
+		setup      func(*types.Params)
+		nonce      uint64
+		notOptedIn bool
+		expErr     error
+	}{
+		"atomic mode": {
+			setup:  func(p *types.Params) { p.ExecutionMode = types.EXECUTION_MODE_ATOMIC },
+			expErr: types.ErrExecuteFailed,
+		},
+		"queue disabled": {
+			setup:  func(p *types.Params) { p.RetryQueueBlocks = 0 },
+			expErr: types.ErrExecuteFailed,
+		},
+		"not opted in": {
+			setup:      func(*types.Params) {},
+			notOptedIn: true,
+			expErr:     types.ErrExecuteFailed,
+		},
+		"ante failure": {
+			setup:  func(*types.Params) {},
+			nonce:  1,
+			expErr: types.ErrAnteFailed,
+		},
+	}
+	for name, spec := range specs {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.enableRetryQueue()
+			params := s.App.OnionKeeper.GetParams(s.Ctx)
+			spec.setup(&params)
+			s.Require().NoError(s.App.OnionKeeper.SetParams(s.Ctx, params))
+
+			edit := s.withRetry(nil)
+			if spec.notOptedIn {
+				edit = nil
+			}
+			tx := newTxWith(s.T(), s.App.TxConfig(), addr, s.Ctx.ChainID(), types.AccountNumber, []sdk.Msg{msg}, spec.nonce, privKey, edit)
+			txBytes, err := s.App.TxConfig().TxEncoder()(tx)
+			s.Require().NoError(err)
+
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+			err = s.App.OnionKeeper.HandleTransferHook(s.Ctx, testPacket, testRelayer, testPacketData(s.onionMemo(txBytes)), s.App.TxConfig())
+			s.Require().ErrorIs(err, spec.expErr)
+			s.Require().Zero(s.onionExecutionEvent(s.Ctx).QueuedId)
+
+			res, err := s.App.OnionKeeper.QueuedTxsBySigner(s.Ctx, &types.QueryQueuedTxsBySignerRequest{Signer: addr.String()})
+			s.Require().NoError(err)
+			s.Require().Empty(res.QueuedTxs)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestPruneRetryQueue() {
+	privKey := secp256k1.GenPrivKeyFromSecret([]byte("test1"))
+	recipient := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test2")).PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("test", 100))
+
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockHeight(10)
+	// the timeout height of the tx comes before the end of the queue window
+	id := s.queueFailingTx(privKey, recipient, coins, func(b client.TxBuilder) {
+		b.SetTimeoutHeight(15)
+	})
+	queued, found, err := s.App.OnionKeeper.GetQueuedTx(s.Ctx, id)
+	s.Require().NoError(err)
+	s.Require().True(found)
+	s.Require().Equal(int64(15), queued.ExpiryHeight)
+
+	s.Ctx = s.Ctx.WithBlockHeight(14)
+	s.Require().NoError(s.App.OnionKeeper.PruneRetryQueue(s.Ctx))
+	_, found, err = s.App.OnionKeeper.GetQueuedTx(s.Ctx, id)
+	s.Require().NoError(err)
+	s.Require().True(found)
+
+	s.Ctx = s.Ctx.WithBlockHeight(15)
+	s.Require().NoError(s.App.OnionKeeper.PruneRetryQueue(s.Ctx))
+	_, found, err = s.App.OnionKeeper.GetQueuedTx(s.Ctx, id)
+	s.Require().NoError(err)
+	s.Require().False(found)
+}
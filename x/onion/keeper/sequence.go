@@ -5,6 +5,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/gogoproto/proto"
 
 	"onion/x/onion/types"
@@ -43,7 +44,8 @@ func (k Keeper) SetSequence(ctx sdk.Context, sequence types.OnionSequence) error
 	return nil
 }
 
-func (k Keeper) GetAllSequences(ctx sdk.Context) []types.OnionSequence {
+// GetAllSequences returns the onion sequences of all addresses.
+func (k Keeper) GetAllSequences(ctx sdk.Context) ([]types.OnionSequence, error) {
 	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.OnionSequencePrefix))
 	defer iterator.Close()
@@ -53,9 +55,35 @@ func (k Keeper) GetAllSequences(ctx sdk.Context) []types.OnionSequence {
 		sequence := types.OnionSequence{}
 		err := proto.Unmarshal(iterator.Value(), &sequence)
 		if err != nil {
-			panic(err)
+			return nil, err
 		}
 		sequences = append(sequences, sequence)
 	}
-	return sequences
+	return sequences, nil
+}
+
+// GetSequencesPaginated returns a page of the onion sequences matching
+// filter.
+func (k Keeper) GetSequencesPaginated(ctx sdk.Context, pageReq *query.PageRequest, filter func(types.OnionSequence) bool) ([]types.OnionSequence, *query.PageResponse, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	prefixStore := prefix.NewStore(store, []byte(types.OnionSequencePrefix))
+
+	sequences := []types.OnionSequence{}
+	pageRes, err := query.FilteredPaginate(prefixStore, pageReq, func(_, value []byte, accumulate bool) (bool, error) {
+		sequence := types.OnionSequence{}
+		if err := proto.Unmarshal(value, &sequence); err != nil {
+			return false, err
+		}
+		if !filter(sequence) {
+			return false, nil
+		}
+		if accumulate {
+			sequences = append(sequences, sequence)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return sequences, pageRes, nil
 }
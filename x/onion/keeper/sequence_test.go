@@ -5,6 +5,7 @@ import (
 
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func (suite *KeeperTestSuite) TestSequence() {
@@ -39,3 +40,77 @@ func (suite *KeeperTestSuite) TestSequence() {
 	suite.Require().Equal(sequence.Address, addr3.String())
 	suite.Require().Equal(sequence.Sequence, uint64(0))
 }
+
+func (suite *KeeperTestSuite) TestSequencesQuery() {
+	suite.SetupTest()
+
+	var sequences []types.OnionSequence
+	for i := 1; i <= 5; i++ {
+		seq := types.OnionSequence{
+			Address:        sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			Sequence:       uint64(i),
+			LastUsedHeight: int64(10 * i),
+		}
+		suite.Require().NoError(suite.App.OnionKeeper.SetSequence(suite.Ctx, seq))
+		sequences = append(sequences, seq)
+	}
+
+	all, err := suite.App.OnionKeeper.GetAllSequences(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(sequences, all)
+
+	specs := map[string]struct {
+		req    *types.QuerySequencesRequest
+		expSeq []uint64
+	}{
+		"all": {
+			req:    &types.QuerySequencesRequest{},
+			expSeq: []uint64{1, 2, 3, 4, 5},
+		},
+		"min sequence": {
+			req:    &types.QuerySequencesRequest{MinSequence: 4},
+			expSeq: []uint64{4, 5},
+		},
+		"active since height": {
+			req:    &types.QuerySequencesRequest{ActiveSinceHeight: 30},
+			expSeq: []uint64{3, 4, 5},
+		},
+		"both filters": {
+			req:    &types.QuerySequencesRequest{MinSequence: 2, ActiveSinceHeight: 45},
+			expSeq: []uint64{5},
+		},
+	}
+	for name, spec := range specs {
+		suite.Run(name, func() {
+			res, err := suite.App.OnionKeeper.Sequences(suite.Ctx, spec.req)
+			suite.Require().NoError(err)
+			var got []uint64
+			for _, seq := range res.Sequences {
+				got = append(got, seq.Sequence)
+			}
+			suite.Require().ElementsMatch(spec.expSeq, got)
+		})
+	}
+
+	// page through the filtered results
+	var (
+		got     []types.OnionSequence
+		nextKey []byte
+	)
+	for {
+		res, err := suite.App.OnionKeeper.Sequences(suite.Ctx, &types.QuerySequencesRequest{
+			Pagination:  &query.PageRequest{Key: nextKey, Limit: 1, CountTotal: nextKey == nil},
+			MinSequence: 2,
+		})
+		suite.Require().NoError(err)
+		suite.Require().LessOrEqual(len(res.Sequences), 1)
+		if nextKey == nil {
+			suite.Require().Equal(uint64(4), res.Pagination.Total)
+		}
+		got = append(got, res.Sequences...)
+		if nextKey = res.Pagination.NextKey; nextKey == nil {
+			break
+		}
+	}
+	suite.Require().ElementsMatch(sequences[1:], got)
+}
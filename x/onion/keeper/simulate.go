@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"onion/x/onion/types"
+)
+
+// SimulateOnion runs the onion tx of a memo like HandleTransferHook would,
+// after crediting the funds of the transfer to the receiver, see creditFunds.
+// Nothing is written, the state is branched and discarded.
+func (k Keeper) SimulateOnion(c context.Context, req *types.QuerySimulateOnionRequest) (*types.QuerySimulateOnionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	parsed, found, err := types.ParseMemo(req.Memo, k.GetParams(ctx).LegacyMemo)
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, "memo does not carry an onion tx")
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	if !req.Funds.Empty() {
+		if err := k.creditFunds(cacheCtx, req.ChannelId, req.Receiver, req.Funds); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+		// a single coin stands for the funds of the packet, see
+		// ExtensionOptionPacketBinding
+		if len(req.Funds) == 1 {
+			cacheCtx = types.WithReceivedFunds(cacheCtx, types.ReceivedFunds{Receiver: req.Receiver, Coin: req.Funds[0]})
+		}
+	}
+
+	// the fee goes to the module account in place of a relayer
+	relayer := authtypes.NewModuleAddress(types.ModuleName)
+
+	var (
+		event   types.EventOnionExecution
+		results []sdk.Result
+	)
+	if err != nil {
+		event.FailedStage = types.EXECUTION_STAGE_DECODE
+		err = errorsmod.Wrap(types.ErrInvalidMemo, err.Error())
+	} else {
+		results, err = k.runTx(cacheCtx, &event, relayer, parsed, k.txDecoder, false)
+	}
+
+	res := &types.QuerySimulateOnionResponse{
+		Success:     err == nil,
+		FailedStage: event.FailedStage,
+		GasLimit:    event.GasLimit,
+		GasUsed:     event.GasUsed,
+	}
+	if err != nil {
+		res.Error = err.Error()
+		_, res.ErrorCode, _ = errorsmod.ABCIInfo(err, false)
+		return res, nil
+	}
+
+	for _, result := range results {
+		res.MsgResponses = append(res.MsgResponses, result.MsgResponses...)
+	}
+	res.Events = make([]abci.Event, 0, len(cacheCtx.EventManager().Events()))
+	for _, ev := range cacheCtx.EventManager().Events() {
+		res.Events = append(res.Events, abci.Event(ev))
+	}
+	return res, nil
+}
+
+// creditFunds credits funds to receiver through the ICS-20 transfer module,
+// as if each coin was received in a packet on channelID of the transfer port.
+// Vouchers of tokens coming from the counterparty are minted by the transfer
+// module and tokens returning to this chain are released from the channel
+// escrow.
+func (k Keeper) creditFunds(ctx sdk.Context, channelID, receiver string, funds sdk.Coins) error {
+	if err := funds.Validate(); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(receiver); err != nil {
+		return errorsmod.Wrap(err, "invalid receiver")
+	}
+	if k.channelKeeper == nil || k.transferKeeper == nil {
+		return errors.New("transfer keepers not set")
+	}
+	channel, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, channelID)
+	if !found {
+		return errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port %s, channel %s", transfertypes.PortID, channelID)
+	}
+	packet := channeltypes.Packet{
+		SourcePort:         channel.Counterparty.PortId,
+		SourceChannel:      channel.Counterparty.ChannelId,
+		DestinationPort:    transfertypes.PortID,
+		DestinationChannel: channelID,
+	}
+
+	for _, coin := range funds {
+		path := coin.Denom
+		if strings.HasPrefix(coin.Denom, transfertypes.DenomPrefix+"/") {
+			var err error
+			if path, err = k.transferKeeper.DenomPathFromHash(ctx, coin.Denom); err != nil {
+				return err
+			}
+		}
+		// the denom as sent by the counterparty
+		denom, ok := strings.CutPrefix(path, transfertypes.GetDenomPrefix(packet.DestinationPort, packet.DestinationChannel))
+		if !ok {
+			denom = transfertypes.GetPrefixedDenom(packet.SourcePort, packet.SourceChannel, path)
+		}
+		data := transfertypes.NewFungibleTokenPacketData(denom, coin.Amount.String(), receiver, receiver, "")
+		if err := k.transferKeeper.OnRecvPacket(ctx, packet, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
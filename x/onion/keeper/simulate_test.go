@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"onion/x/onion/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+func (s *KeeperTestSuite) TestSimulateOnion() {
+	privKey1 := secp256k1.GenPrivKeyFromSecret([]byte("test1"))
+	addr1 := sdk.AccAddress(privKey1.PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test2")).PubKey().Address())
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: addr1.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	voucherTrace := transfertypes.ParseDenomTrace("transfer/channel-0/uatom")
+	voucher := sdk.NewInt64Coin(voucherTrace.IBCDenom(), 100)
+	voucherSend := &banktypes.MsgSend{
+		FromAddress: addr1.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.Coins{voucher},
+	}
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+
+	specs := map[string]struct {
+		funds    sdk.Coins
+		msgs     []sdk.Msg
+		expStage types.ExecutionStage
+		expCode  uint32
+	}{
+		"returning funds of the transfer are spendable": {
+			funds: sdk.Coins{sdk.NewInt64Coin("test", 100)},
+			msgs:  []sdk.Msg{msgSend},
+		},
+		"voucher funds of the transfer are spendable": {
+			funds: sdk.Coins{voucher},
+			msgs:  []sdk.Msg{voucherSend},
+		},
+		"insufficient funds without the transfer": {
+			msgs:     []sdk.Msg{msgSend},
+			expStage: types.EXECUTION_STAGE_EXECUTE,
+			expCode:  types.ErrExecuteFailed.ABCICode(),
+		},
+		"empty messages": {
+			msgs:     []sdk.Msg{},
+			expStage: types.EXECUTION_STAGE_ANTE,
+			expCode:  types.ErrAnteFailed.ABCICode(),
+		},
+	}
+	for name, spec := range specs {
+		spec := spec
+		s.Run(name, func() {
+			s.SetupTest()
+			s.App.IBCKeeper.ChannelKeeper.SetChannel(s.Ctx, transfertypes.PortID, "channel-0", channeltypes.Channel{
+				State:        channeltypes.OPEN,
+				Counterparty: channeltypes.NewCounterparty(transfertypes.PortID, "channel-1"),
+			})
+			s.App.TransferKeeper.SetDenomTrace(s.Ctx, voucherTrace)
+			// tokens of this chain sent over the channel before
+			s.fund(escrow, sdk.Coins{sdk.NewInt64Coin("test", 100)})
+			s.App.TransferKeeper.SetTotalEscrowForDenom(s.Ctx, sdk.NewInt64Coin("test", 100))
+
+			tx := newTx(s.T(), s.App.TxConfig(), addr1, s.Ctx.ChainID(), types.AccountNumber, spec.msgs, 0, privKey1)
+			txBytes, err := s.App.TxConfig().TxEncoder()(tx)
+			s.Require().NoError(err)
+
+			res, err := s.App.OnionKeeper.SimulateOnion(s.Ctx, &types.QuerySimulateOnionRequest{
+				Memo:      s.onionMemo(txBytes),
+				Receiver:  addr1.String(),
+				Funds:     spec.funds,
+				ChannelId: "channel-0",
+			})
+			s.Require().NoError(err)
+
+			if spec.expStage == types.EXECUTION_STAGE_UNSPECIFIED {
+				s.Require().True(res.Success, res.Error)
+				s.Require().Empty(res.Error)
+				s.Require().NotZero(res.GasUsed)
+				s.Require().LessOrEqual(res.GasUsed, res.GasLimit)
+				s.Require().Len(res.MsgResponses, len(spec.msgs))
+				s.Require().Equal("/cosmos.bank.v1beta1.MsgSendResponse", res.MsgResponses[0].TypeUrl)
+
+				transferEvents := 0
+				for _, ev := range res.Events {
+					if ev.Type == banktypes.EventTypeTransfer {
+						transferEvents++
+					}
+				}
+				s.Require().Equal(len(spec.msgs), transferEvents)
+			} else {
+				s.Require().False(res.Success)
+				s.Require().Equal(spec.expStage, res.FailedStage)
+				s.Require().Equal(spec.expCode, res.ErrorCode)
+				s.Require().NotEmpty(res.Error)
+				s.Require().Empty(res.MsgResponses)
+			}
+
+			// nothing is written
+			seq, err := s.App.OnionKeeper.GetSequence(s.Ctx, addr1.String())
+			s.Require().NoError(err)
+			s.Require().Equal(uint64(0), seq.Sequence)
+			s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, addr1).IsZero())
+			s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, addr2).IsZero())
+			s.Require().Equal(sdk.Coins{sdk.NewInt64Coin("test", 100)}, s.App.BankKeeper.GetAllBalances(s.Ctx, escrow))
+			s.Require().True(s.App.BankKeeper.GetSupply(s.Ctx, voucher.Denom).IsZero())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSimulateOnionInvalidRequest() {
+	_, err := s.App.OnionKeeper.SimulateOnion(s.Ctx, &types.QuerySimulateOnionRequest{Memo: `{"forward":{}}`})
+	s.Require().Error(err)
+
+	_, err = s.App.OnionKeeper.SimulateOnion(s.Ctx, &types.QuerySimulateOnionRequest{
+		Memo:      `{"onion":{"tx":"aGVsbG8=","version":1}}`,
+		Receiver:  "invalid",
+		Funds:     sdk.Coins{sdk.NewInt64Coin("test", 100)},
+		ChannelId: "channel-0",
+	})
+	s.Require().Error(err)
+
+	// the funds are credited like by a packet received on a channel
+	_, err = s.App.OnionKeeper.SimulateOnion(s.Ctx, &types.QuerySimulateOnionRequest{
+		Memo:     `{"onion":{"tx":"aGVsbG8=","version":1}}`,
+		Receiver: testReceiver.String(),
+		Funds:    sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	})
+	s.Require().ErrorContains(err, "channel not found")
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	"onion/x/onion/types"
+)
+
+// ValidateTxTimeout rejects an onion tx whose timeout height or signed
+// deadline, set through ExtensionOptionDeadline, has passed. IBC packets may
+// be relayed long after they were sent, the tx must not execute after the
+// signer expected it to.
+func ValidateTxTimeout(ctx sdk.Context, tx sdk.Tx) error {
+	if timeoutTx, ok := tx.(sdk.TxWithTimeoutHeight); ok {
+		timeoutHeight := timeoutTx.GetTimeoutHeight()
+		if timeoutHeight > 0 && uint64(ctx.BlockHeight()) > timeoutHeight {
+			return errorsmod.Wrapf(
+				types.ErrTxExpired, "block height: %d, timeout height: %d", ctx.BlockHeight(), timeoutHeight,
+			)
+		}
+	}
+
+	if extTx, ok := tx.(ante.HasExtensionOptionsTx); ok {
+		for _, option := range extTx.GetExtensionOptions() {
+			deadline, ok := option.GetCachedValue().(*types.ExtensionOptionDeadline)
+			if !ok {
+				continue
+			}
+			if err := ValidateDeadline(ctx, deadline.Deadline); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateDeadline returns ErrTxExpired once the block time is past deadline.
+func ValidateDeadline(ctx sdk.Context, deadline time.Time) error {
+	if ctx.BlockTime().After(deadline) {
+		return errorsmod.Wrapf(
+			types.ErrTxExpired, "block time: %s, deadline: %s",
+			ctx.BlockTime().UTC().Format(time.RFC3339), deadline.UTC().Format(time.RFC3339),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"onion/x/onion/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+func (s *KeeperTestSuite) TestOnReceivePacketHookTimeout() {
+	privKey1 := secp256k1.GenPrivKeyFromSecret([]byte("test1"))
+	addr1 := sdk.AccAddress(privKey1.PubKey().Address())
+	addr2 := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("test2")).PubKey().Address())
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: addr1.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+
+	const blockHeight = 100
+	blockTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	withDeadline := func(deadline time.Time) func(client.TxBuilder) {
+		return func(b client.TxBuilder) {
+			option, err := codectypes.NewAnyWithValue(&types.ExtensionOptionDeadline{Deadline: deadline})
+			s.Require().NoError(err)
+			b.(client.ExtendedTxBuilder).SetExtensionOptions(option)
+		}
+	}
+
+	specs := map[string]struct {
+		edit         func(client.TxBuilder)
+		memoDeadline *time.Time
+		expExpired   bool
+	}{
+		"no timeout": {},
+		"timeout height not reached": {
+			edit: func(b client.TxBuilder) { b.SetTimeoutHeight(blockHeight) },
+		},
+		"timeout height passed": {
+			edit:       func(b client.TxBuilder) { b.SetTimeoutHeight(blockHeight - 1) },
+			expExpired: true,
+		},
+		"signed deadline not reached": {
+			edit: withDeadline(blockTime),
+		},
+		"signed deadline passed": {
+			edit:       withDeadline(blockTime.Add(-time.Second)),
+			expExpired: true,
+		},
+		"memo deadline not reached": {
+			memoDeadline: &blockTime,
+		},
+		"memo deadline passed": {
+			memoDeadline: func() *time.Time { t := blockTime.Add(-time.Second); return &t }(),
+			expExpired:   true,
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			s.SetupTest()
+			s.Ctx = s.Ctx.WithBlockHeight(blockHeight).WithBlockTime(blockTime)
+			coins := sdk.Coins{sdk.NewInt64Coin("test", 500)}
+			s.Require().NoError(s.App.BankKeeper.MintCoins(s.Ctx, minttypes.ModuleName, coins))
+			s.Require().NoError(s.App.BankKeeper.SendCoinsFromModuleToAccount(s.Ctx, minttypes.ModuleName, addr1, coins))
+
+			tx := newTxWith(s.T(), s.App.TxConfig(), addr1, s.Ctx.ChainID(), types.AccountNumber, []sdk.Msg{msgSend}, 0, privKey1, spec.edit)
+			txBytes, err := s.App.TxConfig().TxEncoder()(tx)
+			s.Require().NoError(err)
+
+			memo := s.onionMemo(txBytes)
+			if spec.memoDeadline != nil {
+				memo, err = types.NewMemoWithDeadline(txBytes, *spec.memoDeadline)
+				s.Require().NoError(err)
+			}
+
+			// the signed checks are part of the ante handler as well
+			anteCtx, _ := s.Ctx.CacheContext()
+			anteErr := s.App.OnionKeeper.ExecuteAnte(anteCtx, tx, testRelayer)
+
+			err = s.App.OnionKeeper.HandleTransferHook(s.Ctx, testPacket, testRelayer, testPacketData(memo), s.App.TxConfig())
+			event := s.onionExecutionEvent(s.Ctx)
+			seq, seqErr := s.App.OnionKeeper.GetSequence(s.Ctx, addr1.String())
+			s.Require().NoError(seqErr)
+			if !spec.expExpired {
+				s.Require().NoError(anteErr)
+				s.Require().NoError(err)
+				s.Require().True(event.Success)
+				s.Require().Equal(uint64(1), seq.Sequence)
+				return
+			}
+
+			if spec.memoDeadline == nil {
+				s.Require().ErrorIs(anteErr, types.ErrTxExpired)
+			}
+			s.Require().ErrorIs(err, types.ErrTxExpired)
+			s.Require().NotErrorIs(err, types.ErrAnteFailed)
+			s.Require().Equal(types.EXECUTION_STAGE_ANTE, event.FailedStage)
+			s.Require().Equal(types.ErrTxExpired.ABCICode(), event.ErrorCode)
+			s.Require().Equal(uint64(0), seq.Sequence)
+
+			ack := channeltypes.NewErrorAcknowledgement(err)
+			s.Require().Contains(ack.GetError(), fmt.Sprintf("ABCI code: %d", types.ErrTxExpired.ABCICode()))
+		})
+	}
+}
@@ -10,7 +10,8 @@ import (
 func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 	return &autocliv1.ModuleOptions{
 		Query: &autocliv1.ServiceCommandDescriptor{
-			Service: modulev1.Query_ServiceDesc.ServiceName,
+			Service:              modulev1.Query_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true,
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{
 					RpcMethod: "Params",
@@ -22,6 +23,50 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "sequence",
 					Short:     "Query the onion sequence of an address",
 				},
+				{
+					RpcMethod: "Sequences",
+					Use:       "sequences",
+					Short:     "List the onion sequences of all addresses that have used onion txs",
+				},
+				{
+					RpcMethod: "EnabledChannels",
+					Use:       "enabled-channels",
+					Short:     "List the channels on which onion txs are executed",
+				},
+				{
+					RpcMethod:      "SimulateOnion",
+					Use:            "simulate-onion [memo]",
+					Short:          "Simulate the onion tx of a memo, optionally crediting --funds received on --channel-id to --receiver first",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "memo"}},
+				},
+				{
+					RpcMethod: "Execution",
+					Use:       "execution [port-id] [channel-id] [packet-sequence]",
+					Short:     "Query the receipt of the onion tx carried by a received packet",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "port_id"},
+						{ProtoField: "channel_id"},
+						{ProtoField: "packet_sequence"},
+					},
+				},
+				{
+					RpcMethod:      "ExecutionsBySigner",
+					Use:            "executions-by-signer [signer]",
+					Short:          "List the receipts of onion txs signed by an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "signer"}},
+				},
+				{
+					RpcMethod:      "QueuedTx",
+					Use:            "queued-tx [id]",
+					Short:          "Query an onion tx of the retry queue",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "QueuedTxsBySigner",
+					Use:            "queued-txs-by-signer [signer]",
+					Short:          "List the onion txs of the retry queue signed by an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "signer"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -33,6 +78,16 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "ExecuteOnion",
+					Skip:      true, // custom command taking a memo
+				},
+				{
+					RpcMethod:      "RetryOnion",
+					Use:            "retry-onion [id]",
+					Short:          "Retry an onion tx of the retry queue, paying its gas",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
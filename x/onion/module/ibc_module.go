@@ -8,6 +8,7 @@ import (
 
 	// ibc-go
 	"onion/x/onion/keeper"
+	"onion/x/onion/types"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
@@ -110,13 +111,31 @@ func (im IBCModule) OnRecvPacket(
 ) ibcexported.Acknowledgement {
 	ack := im.App.OnRecvPacket(ctx, packet, relayer)
 
+	// the onion tx may only spend funds the transfer has actually credited,
+	// failed and asynchronous acknowledgements are passed through untouched
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	// memos received on channels that are not enabled are never decoded
+	params := im.Keeper.GetParams(ctx)
+	if !params.IsChannelEnabled(packet.DestinationPort, packet.DestinationChannel) {
+		return ack
+	}
+
 	var data transfertypes.FungibleTokenPacketData
 	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
 		return ack
 	}
 
 	if data.Memo != "" {
-		im.Keeper.HandleTransferHook(ctx, data.Memo, im.txEncodingConfig)
+		err := im.Keeper.HandleTransferHook(ctx, packet, relayer, data, im.txEncodingConfig)
+		// the EventOnionExecution of the failed tx is emitted on ctx, ibc-go
+		// discards it together with the state changes of the error
+		// acknowledgement
+		if err != nil && params.ExecutionMode == types.EXECUTION_MODE_ATOMIC {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
 	}
 
 	return ack
@@ -0,0 +1,624 @@
+package onion_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	txsigning "cosmossdk.io/x/tx/signing"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/gogoproto/proto"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibcmock "github.com/cosmos/ibc-go/v8/testing/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	app "onion/app"
+	onion "onion/x/onion/module"
+	"onion/x/onion/types"
+)
+
+type IBCModuleTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	path   *ibctesting.Path
+}
+
+func TestIBCModuleTestSuite(t *testing.T) {
+	suite.Run(t, new(IBCModuleTestSuite))
+}
+
+func (suite *IBCModuleTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(suite.path)
+
+	suite.setOnionParams(func(params *types.Params) {})
+}
+
+// setOnionParams enables onion execution on the chainB end of the suite path
+// and applies edit to the params before storing them.
+func (suite *IBCModuleTestSuite) setOnionParams(edit func(params *types.Params)) {
+	params := types.DefaultParams()
+	params.EnabledChannels = []types.EnabledChannel{{
+		PortId:    suite.path.EndpointB.ChannelConfig.PortID,
+		ChannelId: suite.path.EndpointB.ChannelID,
+	}}
+	edit(&params)
+	err := suite.onionApp(suite.chainB).OnionKeeper.SetParams(suite.chainB.GetContext(), params)
+	suite.Require().NoError(err)
+}
+
+func (suite *IBCModuleTestSuite) onionApp(chain *ibctesting.TestChain) *app.App {
+	onionApp, ok := chain.App.(testingApp)
+	suite.Require().True(ok)
+	return onionApp.App
+}
+
+// transferWithMemo sends coins from chainA to receiver on chainB over the
+// suite path, relays the packet and returns the acknowledgement.
+func (suite *IBCModuleTestSuite) transferWithMemo(receiver string, amount sdk.Coin, memo string) channeltypes.Acknowledgement {
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID,
+		suite.path.EndpointA.ChannelID,
+		amount,
+		suite.chainA.SenderAccount.GetAddress().String(),
+		receiver,
+		clienttypes.NewHeight(1, 110),
+		0,
+		memo,
+	)
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	_, ackBz, err := suite.path.RelayPacketWithResults(packet)
+	suite.Require().NoError(err)
+
+	var ack channeltypes.Acknowledgement
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(ackBz, &ack))
+	return ack
+}
+
+func (suite *IBCModuleTestSuite) TestOnRecvPacketExecutesOnionTx() {
+	privKey := secp256k1.GenPrivKeyFromSecret([]byte("onion"))
+	signer := sdk.AccAddress(privKey.PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("recipient")).PubKey().Address())
+
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.path.EndpointB.ChannelConfig.PortID,
+		suite.path.EndpointB.ChannelID,
+		sdk.DefaultBondDenom,
+	)).IBCDenom()
+
+	chainBApp := suite.onionApp(suite.chainB)
+	memo := newOnionMemo(suite.T(), chainBApp.TxConfig(), suite.chainB.ChainID, 0, privKey, &banktypes.MsgSend{
+		FromAddress: signer.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(voucherDenom, 400)),
+	})
+
+	ack := suite.transferWithMemo(signer.String(), sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)), memo)
+	suite.Require().True(ack.Success())
+
+	ctx := suite.chainB.GetContext()
+	suite.Require().Equal(
+		sdk.NewInt64Coin(voucherDenom, 600).String(),
+		chainBApp.BankKeeper.GetBalance(ctx, signer, voucherDenom).String(),
+	)
+	suite.Require().Equal(
+		sdk.NewInt64Coin(voucherDenom, 400).String(),
+		chainBApp.BankKeeper.GetBalance(ctx, recipient, voucherDenom).String(),
+	)
+
+	seq, err := chainBApp.OnionKeeper.GetSequence(ctx, signer.String())
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), seq.Sequence)
+}
+
+func (suite *IBCModuleTestSuite) TestOnRecvPacketFailedOnionTx() {
+	privKey := secp256k1.GenPrivKeyFromSecret([]byte("onion"))
+	signer := sdk.AccAddress(privKey.PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("recipient")).PubKey().Address())
+
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.path.EndpointB.ChannelConfig.PortID,
+		suite.path.EndpointB.ChannelID,
+		sdk.DefaultBondDenom,
+	)).IBCDenom()
+
+	specs := map[string]struct {
+		mode       types.ExecutionMode
+		expSuccess bool
+		expBalance sdk.Coin
+		expSeq     uint64
+	}{
+		"best effort keeps the transfer": {
+			mode:       types.EXECUTION_MODE_BEST_EFFORT,
+			expSuccess: true,
+			expBalance: sdk.NewInt64Coin(voucherDenom, 1000),
+			expSeq:     1,
+		},
+		"atomic refunds the transfer": {
+			mode:       types.EXECUTION_MODE_ATOMIC,
+			expSuccess: false,
+			expBalance: sdk.NewInt64Coin(voucherDenom, 0),
+		},
+	}
+	for name, spec := range specs {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			chainBApp := suite.onionApp(suite.chainB)
+			suite.setOnionParams(func(params *types.Params) {
+				params.ExecutionMode = spec.mode
+			})
+
+			senderBalance := suite.onionApp(suite.chainA).BankKeeper.GetBalance(
+				suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom,
+			)
+
+			// spends more than the transfer delivers
+			memo := newOnionMemo(suite.T(), chainBApp.TxConfig(), suite.chainB.ChainID, 0, privKey, &banktypes.MsgSend{
+				FromAddress: signer.String(),
+				ToAddress:   recipient.String(),
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin(voucherDenom, 2000)),
+			})
+
+			ack := suite.transferWithMemo(signer.String(), sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)), memo)
+			suite.Require().Equal(spec.expSuccess, ack.Success())
+
+			ctx := suite.chainB.GetContext()
+			suite.Require().Equal(spec.expBalance.String(), chainBApp.BankKeeper.GetBalance(ctx, signer, voucherDenom).String())
+			seq, err := chainBApp.OnionKeeper.GetSequence(ctx, signer.String())
+			suite.Require().NoError(err)
+			suite.Require().Equal(spec.expSeq, seq.Sequence)
+
+			expSenderBalance := senderBalance.SubAmount(sdkmath.NewInt(1000))
+			if !spec.expSuccess {
+				suite.Require().Contains(ack.GetError(), fmt.Sprintf("ABCI code: %d", types.ErrExecuteFailed.ABCICode()))
+				expSenderBalance = senderBalance
+			}
+			suite.Require().Equal(expSenderBalance.String(), suite.onionApp(suite.chainA).BankKeeper.GetBalance(
+				suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom,
+			).String())
+		})
+	}
+}
+
+func (suite *IBCModuleTestSuite) TestOnRecvPacketAtomicFailureEvent() {
+	privKey := secp256k1.GenPrivKeyFromSecret([]byte("onion"))
+	signer := sdk.AccAddress(privKey.PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("recipient")).PubKey().Address())
+
+	chainBApp := suite.onionApp(suite.chainB)
+	suite.setOnionParams(func(params *types.Params) {
+		params.ExecutionMode = types.EXECUTION_MODE_ATOMIC
+	})
+
+	// the signer has no funds to send
+	memo := newOnionMemo(suite.T(), chainBApp.TxConfig(), suite.chainB.ChainID, 0, privKey, &banktypes.MsgSend{
+		FromAddress: signer.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000)),
+	})
+	data := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "1000", recipient.String(), signer.String(), memo)
+	packet := channeltypes.Packet{
+		Sequence:           1,
+		SourcePort:         suite.path.EndpointA.ChannelConfig.PortID,
+		SourceChannel:      suite.path.EndpointA.ChannelID,
+		DestinationPort:    suite.path.EndpointB.ChannelConfig.PortID,
+		DestinationChannel: suite.path.EndpointB.ChannelID,
+		Data:               data.GetBytes(),
+	}
+
+	// the transfer itself succeeds without crediting anything
+	transferApp := ibcmock.NewIBCModule(&ibcmock.AppModule{}, &ibcmock.IBCApp{
+		OnRecvPacket: func(sdk.Context, channeltypes.Packet, sdk.AccAddress) ibcexported.Acknowledgement {
+			return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+		},
+	})
+	im := onion.NewIBCModule(transferApp, chainBApp.OnionKeeper, chainBApp.TxConfig())
+
+	ctx := suite.chainB.GetContext().WithEventManager(sdk.NewEventManager())
+	ack := im.OnRecvPacket(ctx, packet, recipient)
+	suite.Require().False(ack.Success())
+
+	// the event of the failed attempt is emitted on the middleware's
+	// context, ibc-go discards it along with the error acknowledgement
+	var event *types.EventOnionExecution
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type != proto.MessageName(&types.EventOnionExecution{}) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(ev))
+		suite.Require().NoError(err)
+		event = msg.(*types.EventOnionExecution)
+	}
+	suite.Require().NotNil(event)
+	suite.Require().False(event.Success)
+	suite.Require().Equal(types.EXECUTION_STAGE_EXECUTE, event.FailedStage)
+	suite.Require().Equal(types.ErrExecuteFailed.ABCICode(), event.ErrorCode)
+	suite.Require().Equal(packet.Sequence, event.PacketSequence)
+}
+
+func (suite *IBCModuleTestSuite) TestOnRecvPacketChannelNotEnabled() {
+	privKey := secp256k1.GenPrivKeyFromSecret([]byte("onion"))
+	signer := sdk.AccAddress(privKey.PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("recipient")).PubKey().Address())
+
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.path.EndpointB.ChannelConfig.PortID,
+		suite.path.EndpointB.ChannelID,
+		sdk.DefaultBondDenom,
+	)).IBCDenom()
+
+	chainBApp := suite.onionApp(suite.chainB)
+	suite.setOnionParams(func(params *types.Params) {
+		params.EnabledChannels = nil
+	})
+
+	memo := newOnionMemo(suite.T(), chainBApp.TxConfig(), suite.chainB.ChainID, 0, privKey, &banktypes.MsgSend{
+		FromAddress: signer.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(voucherDenom, 400)),
+	})
+
+	ack := suite.transferWithMemo(signer.String(), sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)), memo)
+	suite.Require().True(ack.Success())
+
+	// the transfer is credited but the memo is ignored
+	ctx := suite.chainB.GetContext()
+	suite.Require().Equal(
+		sdk.NewInt64Coin(voucherDenom, 1000).String(),
+		chainBApp.BankKeeper.GetBalance(ctx, signer, voucherDenom).String(),
+	)
+	seq, err := chainBApp.OnionKeeper.GetSequence(ctx, signer.String())
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), seq.Sequence)
+
+	res, err := chainBApp.OnionKeeper.EnabledChannels(ctx, &types.QueryEnabledChannelsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Channels)
+}
+
+func (suite *IBCModuleTestSuite) TestOnRecvPacketWithoutMemo() {
+	receiver := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("receiver")).PubKey().Address())
+
+	ack := suite.transferWithMemo(receiver.String(), sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)), "")
+	suite.Require().True(ack.Success())
+
+	seq, err := suite.onionApp(suite.chainB).OnionKeeper.GetSequence(suite.chainB.GetContext(), receiver.String())
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), seq.Sequence)
+}
+
+func (suite *IBCModuleTestSuite) TestOnRecvPacketAcknowledgements() {
+	privKey := secp256k1.GenPrivKeyFromSecret([]byte("onion"))
+	signer := sdk.AccAddress(privKey.PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("recipient")).PubKey().Address())
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: signer.String(),
+		ToAddress:   recipient.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+	}
+
+	specs := map[string]struct {
+		ack        ibcexported.Acknowledgement
+		disabled   bool
+		expExecute bool
+	}{
+		"successful acknowledgement": {
+			ack:        channeltypes.NewResultAcknowledgement([]byte{byte(1)}),
+			expExecute: true,
+		},
+		"error acknowledgement": {
+			ack:        channeltypes.NewErrorAcknowledgement(errors.New("transfer failed")),
+			expExecute: false,
+		},
+		"async acknowledgement": {
+			ack:        nil,
+			expExecute: false,
+		},
+		"channel not enabled": {
+			ack:        channeltypes.NewResultAcknowledgement([]byte{byte(1)}),
+			disabled:   true,
+			expExecute: false,
+		},
+	}
+	for name, spec := range specs {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			chainBApp := suite.onionApp(suite.chainB)
+			if spec.disabled {
+				suite.setOnionParams(func(params *types.Params) {
+					params.EnabledChannels = nil
+				})
+			}
+
+			// the mock transfer app does not credit anything, the signer is
+			// funded upfront
+			ctx := suite.chainB.GetContext()
+			coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))
+			suite.Require().NoError(chainBApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+			suite.Require().NoError(chainBApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, signer, coins))
+			recipientBalance := chainBApp.BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom)
+
+			memo := newOnionMemo(suite.T(), chainBApp.TxConfig(), suite.chainB.ChainID, 0, privKey, msgSend)
+			data := transfertypes.NewFungibleTokenPacketData(sdk.DefaultBondDenom, "500", recipient.String(), signer.String(), memo)
+			packet := channeltypes.Packet{
+				Sequence:           1,
+				SourcePort:         suite.path.EndpointA.ChannelConfig.PortID,
+				SourceChannel:      suite.path.EndpointA.ChannelID,
+				DestinationPort:    suite.path.EndpointB.ChannelConfig.PortID,
+				DestinationChannel: suite.path.EndpointB.ChannelID,
+				Data:               data.GetBytes(),
+			}
+
+			transferApp := ibcmock.NewIBCModule(&ibcmock.AppModule{}, &ibcmock.IBCApp{
+				OnRecvPacket: func(sdk.Context, channeltypes.Packet, sdk.AccAddress) ibcexported.Acknowledgement {
+					return spec.ack
+				},
+			})
+			im := onion.NewIBCModule(transferApp, chainBApp.OnionKeeper, chainBApp.TxConfig())
+
+			ack := im.OnRecvPacket(ctx, packet, recipient)
+			suite.Require().Equal(spec.ack, ack)
+
+			expSeq, expBalance := uint64(0), recipientBalance
+			if spec.expExecute {
+				expSeq, expBalance = 1, recipientBalance.AddAmount(sdkmath.NewInt(100))
+			}
+			seq, err := chainBApp.OnionKeeper.GetSequence(ctx, signer.String())
+			suite.Require().NoError(err)
+			suite.Require().Equal(expSeq, seq.Sequence)
+			suite.Require().Equal(expBalance.String(), chainBApp.BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom).String())
+		})
+	}
+}
+
+func (suite *IBCModuleTestSuite) TestOnRecvPacketRecovery() {
+	privKey := secp256k1.GenPrivKeyFromSecret([]byte("onion"))
+	signer := sdk.AccAddress(privKey.PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("recipient")).PubKey().Address())
+	fallback := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("fallback")).PubKey().Address())
+
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.path.EndpointB.ChannelConfig.PortID,
+		suite.path.EndpointB.ChannelID,
+		sdk.DefaultBondDenom,
+	)).IBCDenom()
+
+	specs := map[string]struct {
+		recovery      func(sender sdk.AccAddress) types.ExtensionOptionRecovery
+		expReturned   bool
+		expFallback   sdk.Coin
+		expSignerLeft sdk.Coin
+	}{
+		"recovery address": {
+			recovery: func(sdk.AccAddress) types.ExtensionOptionRecovery {
+				return types.ExtensionOptionRecovery{Address: fallback.String()}
+			},
+			expFallback:   sdk.NewInt64Coin(voucherDenom, 1000),
+			expSignerLeft: sdk.NewInt64Coin(voucherDenom, 0),
+		},
+		"return transfer": {
+			recovery: func(sender sdk.AccAddress) types.ExtensionOptionRecovery {
+				return types.ExtensionOptionRecovery{ReturnReceiver: sender.String()}
+			},
+			expReturned:   true,
+			expFallback:   sdk.NewInt64Coin(voucherDenom, 0),
+			expSignerLeft: sdk.NewInt64Coin(voucherDenom, 0),
+		},
+		"invalid instruction keeps the funds": {
+			recovery: func(sender sdk.AccAddress) types.ExtensionOptionRecovery {
+				return types.ExtensionOptionRecovery{Address: fallback.String(), ReturnReceiver: sender.String()}
+			},
+			expFallback:   sdk.NewInt64Coin(voucherDenom, 0),
+			expSignerLeft: sdk.NewInt64Coin(voucherDenom, 1000),
+		},
+	}
+	for name, spec := range specs {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			chainAApp, chainBApp := suite.onionApp(suite.chainA), suite.onionApp(suite.chainB)
+			sender := suite.chainA.SenderAccount.GetAddress()
+			recovery := spec.recovery(sender)
+			senderBalance := chainAApp.BankKeeper.GetBalance(suite.chainA.GetContext(), sender, sdk.DefaultBondDenom)
+
+			// spends more than the transfer delivers
+			memo := newOnionMemoWith(suite.T(), chainBApp.TxConfig(), suite.chainB.ChainID, 0, privKey, func(b client.TxBuilder) {
+				option, err := codectypes.NewAnyWithValue(&recovery)
+				suite.Require().NoError(err)
+				b.(client.ExtendedTxBuilder).SetExtensionOptions(option)
+			}, &banktypes.MsgSend{
+				FromAddress: signer.String(),
+				ToAddress:   recipient.String(),
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin(voucherDenom, 2000)),
+			})
+
+			msg := transfertypes.NewMsgTransfer(
+				suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+				sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)), sender.String(), signer.String(),
+				clienttypes.NewHeight(1, 110), 0, memo,
+			)
+			res, err := suite.chainA.SendMsgs(msg)
+			suite.Require().NoError(err)
+			packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+			suite.Require().NoError(err)
+			recvRes, ackBz, err := suite.path.RelayPacketWithResults(packet)
+			suite.Require().NoError(err)
+
+			var ack channeltypes.Acknowledgement
+			suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(ackBz, &ack))
+			suite.Require().True(ack.Success())
+
+			ctx := suite.chainB.GetContext()
+			suite.Require().Equal(spec.expSignerLeft.String(), chainBApp.BankKeeper.GetBalance(ctx, signer, voucherDenom).String())
+			suite.Require().Equal(spec.expFallback.String(), chainBApp.BankKeeper.GetBalance(ctx, fallback, voucherDenom).String())
+
+			expSenderBalance := senderBalance.SubAmount(sdkmath.NewInt(1000))
+			if spec.expReturned {
+				returnPacket, err := ibctesting.ParsePacketFromEvents(recvRes.Events)
+				suite.Require().NoError(err)
+				suite.Require().NoError(suite.path.RelayPacket(returnPacket))
+				expSenderBalance = senderBalance
+			}
+			suite.Require().Equal(expSenderBalance.String(), chainAApp.BankKeeper.GetBalance(
+				suite.chainA.GetContext(), sender, sdk.DefaultBondDenom,
+			).String())
+		})
+	}
+}
+
+func (suite *IBCModuleTestSuite) TestOnRecvPacketRecoveryReplay() {
+	privKey := secp256k1.GenPrivKeyFromSecret([]byte("onion"))
+	signer := sdk.AccAddress(privKey.PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("recipient")).PubKey().Address())
+	fallback := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("fallback")).PubKey().Address())
+
+	voucherDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.path.EndpointB.ChannelConfig.PortID,
+		suite.path.EndpointB.ChannelID,
+		sdk.DefaultBondDenom,
+	)).IBCDenom()
+
+	specs := map[string]struct {
+		fee sdk.Coins
+	}{
+		// the ante checks use the onion sequence
+		"messages fail": {},
+		// the ante state is discarded, the recovery uses the onion sequence
+		"ante fails": {
+			fee: sdk.NewCoins(sdk.NewInt64Coin(voucherDenom, 5000)),
+		},
+	}
+	for name, spec := range specs {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			chainBApp := suite.onionApp(suite.chainB)
+			recovery := types.ExtensionOptionRecovery{Address: fallback.String()}
+
+			// spends more than the transfer delivers
+			memo := newOnionMemoWith(suite.T(), chainBApp.TxConfig(), suite.chainB.ChainID, 0, privKey, func(b client.TxBuilder) {
+				option, err := codectypes.NewAnyWithValue(&recovery)
+				suite.Require().NoError(err)
+				b.(client.ExtendedTxBuilder).SetExtensionOptions(option)
+				b.SetFeeAmount(spec.fee)
+			}, &banktypes.MsgSend{
+				FromAddress: signer.String(),
+				ToAddress:   recipient.String(),
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin(voucherDenom, 2000)),
+			})
+
+			ack := suite.transferWithMemo(signer.String(), sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)), memo)
+			suite.Require().True(ack.Success())
+
+			ctx := suite.chainB.GetContext()
+			suite.Require().Equal(sdk.NewInt64Coin(voucherDenom, 1000).String(), chainBApp.BankKeeper.GetBalance(ctx, fallback, voucherDenom).String())
+			seq, err := chainBApp.OnionKeeper.GetSequence(ctx, signer.String())
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(1), seq.Sequence)
+
+			// the same memo sent with a new transfer fails the sequence check
+			// and does not redirect the new funds
+			ack = suite.transferWithMemo(signer.String(), sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(700)), memo)
+			suite.Require().True(ack.Success())
+
+			ctx = suite.chainB.GetContext()
+			suite.Require().Equal(sdk.NewInt64Coin(voucherDenom, 700).String(), chainBApp.BankKeeper.GetBalance(ctx, signer, voucherDenom).String())
+			suite.Require().Equal(sdk.NewInt64Coin(voucherDenom, 1000).String(), chainBApp.BankKeeper.GetBalance(ctx, fallback, voucherDenom).String())
+			seq, err = chainBApp.OnionKeeper.GetSequence(ctx, signer.String())
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(1), seq.Sequence)
+		})
+	}
+}
+
+// newOnionMemo signs msgs with the onion account number for chainID and
+// returns the tx wrapped in the ICS-20 memo envelope.
+func newOnionMemo(t *testing.T, cfg client.TxConfig, chainID string, nonce uint64, privKey *secp256k1.PrivKey, msgs ...sdk.Msg) string {
+	return newOnionMemoWith(t, cfg, chainID, nonce, privKey, nil, msgs...)
+}
+
+// newOnionMemoWith is like newOnionMemo, edit is applied to the builder
+// before signing to set e.g. extension options.
+func newOnionMemoWith(t *testing.T, cfg client.TxConfig, chainID string, nonce uint64, privKey *secp256k1.PrivKey, edit func(client.TxBuilder), msgs ...sdk.Msg) string {
+	builder := cfg.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	builder.SetGasLimit(flags.DefaultGasLimit)
+	if edit != nil {
+		edit(builder)
+	}
+
+	pubKey := privKey.PubKey()
+	signMode, err := authsigning.APISignModeToInternal(cfg.SignModeHandler().DefaultMode())
+	require.NoError(t, err)
+
+	sig := signingtypes.SignatureV2{
+		PubKey:   pubKey,
+		Sequence: nonce,
+		Data: &signingtypes.SingleSignatureData{
+			SignMode: signMode,
+		},
+	}
+	require.NoError(t, builder.SetSignatures(sig))
+
+	anyPk, err := codectypes.NewAnyWithValue(pubKey)
+	require.NoError(t, err)
+	signerData := txsigning.SignerData{
+		Address:       sdk.AccAddress(pubKey.Address()).String(),
+		ChainID:       chainID,
+		AccountNumber: types.AccountNumber,
+		Sequence:      nonce,
+		PubKey: &anypb.Any{
+			TypeUrl: anyPk.TypeUrl,
+			Value:   anyPk.Value,
+		},
+	}
+
+	adaptableTx, ok := builder.GetTx().(authsigning.V2AdaptableTx)
+	require.True(t, ok)
+	signBytes, err := cfg.SignModeHandler().GetSignBytes(
+		context.Background(),
+		cfg.SignModeHandler().DefaultMode(),
+		signerData,
+		adaptableTx.GetSigningTxData(),
+	)
+	require.NoError(t, err)
+
+	sig.Data.(*signingtypes.SingleSignatureData).Signature, err = privKey.Sign(signBytes)
+	require.NoError(t, err)
+	require.NoError(t, builder.SetSignatures(sig))
+
+	txBytes, err := cfg.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	memo, err := types.NewMemo(txBytes)
+	require.NoError(t, err)
+	return memo
+}
@@ -0,0 +1,196 @@
+package onion_test
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/stretchr/testify/suite"
+
+	app "onion/app"
+	"onion/x/onion/client/cli"
+	"onion/x/onion/types"
+)
+
+// RouteTestSuite runs onion routes over chainA -> chainB -> chainC.
+type RouteTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	chainC *ibctesting.TestChain
+	pathAB *ibctesting.Path
+	pathBC *ibctesting.Path
+}
+
+func TestRouteTestSuite(t *testing.T) {
+	suite.Run(t, new(RouteTestSuite))
+}
+
+func (suite *RouteTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 3)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(3))
+
+	suite.pathAB = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(suite.pathAB)
+	suite.pathBC = ibctesting.NewTransferPath(suite.chainB, suite.chainC)
+	suite.coordinator.Setup(suite.pathBC)
+
+	// onion txs are executed on the receiving end of both paths
+	for _, endpoint := range []*ibctesting.Endpoint{suite.pathAB.EndpointB, suite.pathBC.EndpointB} {
+		params := types.DefaultParams()
+		params.EnabledChannels = []types.EnabledChannel{{
+			PortId:    endpoint.ChannelConfig.PortID,
+			ChannelId: endpoint.ChannelID,
+		}}
+		err := suite.onionApp(endpoint.Chain).OnionKeeper.SetParams(endpoint.Chain.GetContext(), params)
+		suite.Require().NoError(err)
+	}
+}
+
+func (suite *RouteTestSuite) onionApp(chain *ibctesting.TestChain) *app.App {
+	onionApp, ok := chain.App.(testingApp)
+	suite.Require().True(ok)
+	return onionApp.App
+}
+
+// relay relays packet over path and returns the acknowledgement and the
+// events of receiving it.
+func (suite *RouteTestSuite) relay(path *ibctesting.Path, packet channeltypes.Packet) (channeltypes.Acknowledgement, sdk.Events) {
+	res, ackBz, err := path.RelayPacketWithResults(packet)
+	suite.Require().NoError(err)
+
+	var ack channeltypes.Acknowledgement
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(ackBz, &ack))
+	var events sdk.Events
+	for _, event := range res.Events {
+		events = append(events, sdk.Event(event))
+	}
+	return ack, events
+}
+
+func (suite *RouteTestSuite) TestRoutePeelsTwoLayers() {
+	kr := keyring.NewInMemory(suite.onionApp(suite.chainA).AppCodec())
+	privKeyB := secp256k1.GenPrivKeyFromSecret([]byte("hop-b"))
+	privKeyC := secp256k1.GenPrivKeyFromSecret([]byte("hop-c"))
+	suite.Require().NoError(kr.ImportPrivKeyHex("b", hex.EncodeToString(privKeyB.Bytes()), string(hd.Secp256k1Type)))
+	suite.Require().NoError(kr.ImportPrivKeyHex("c", hex.EncodeToString(privKeyC.Bytes()), string(hd.Secp256k1Type)))
+	signerB := sdk.AccAddress(privKeyB.PubKey().Address())
+	signerC := sdk.AccAddress(privKeyC.PubKey().Address())
+	recipient := sdk.AccAddress(secp256k1.GenPrivKeyFromSecret([]byte("recipient")).PubKey().Address())
+
+	denomB := transfertypes.GetPrefixedDenom(
+		suite.pathAB.EndpointB.ChannelConfig.PortID,
+		suite.pathAB.EndpointB.ChannelID,
+		sdk.DefaultBondDenom,
+	)
+	voucherB := transfertypes.ParseDenomTrace(denomB).IBCDenom()
+	voucherC := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.pathBC.EndpointB.ChannelConfig.PortID,
+		suite.pathBC.EndpointB.ChannelID,
+		denomB,
+	)).IBCDenom()
+
+	chainBApp, chainCApp := suite.onionApp(suite.chainB), suite.onionApp(suite.chainC)
+	memo, err := cli.BuildRouteMemo(context.Background(), chainBApp.TxConfig(), kr, []cli.RouteHop{
+		{
+			ChainID:  suite.chainB.ChainID,
+			Key:      "b",
+			GasLimit: 500_000,
+			Msgs: []sdk.Msg{&banktypes.MsgSend{
+				FromAddress: signerB.String(),
+				ToAddress:   recipient.String(),
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin(voucherB, 100)),
+			}},
+			Channel:       suite.pathBC.EndpointA.ChannelID,
+			Token:         sdk.NewInt64Coin(voucherB, 600),
+			TimeoutHeight: clienttypes.NewHeight(1, 110),
+		},
+		{
+			ChainID: suite.chainC.ChainID,
+			Key:     "c",
+			Msgs: []sdk.Msg{&banktypes.MsgSend{
+				FromAddress: signerC.String(),
+				ToAddress:   recipient.String(),
+				Amount:      sdk.NewCoins(sdk.NewInt64Coin(voucherC, 250)),
+			}},
+		},
+	})
+	suite.Require().NoError(err)
+
+	msg := transfertypes.NewMsgTransfer(
+		suite.pathAB.EndpointA.ChannelConfig.PortID,
+		suite.pathAB.EndpointA.ChannelID,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1000)),
+		suite.chainA.SenderAccount.GetAddress().String(),
+		signerB.String(),
+		clienttypes.NewHeight(1, 110),
+		0,
+		memo,
+	)
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	// the first layer runs on chainB and sends the second one to chainC
+	ack, events := suite.relay(suite.pathAB, packet)
+	suite.Require().True(ack.Success())
+	packet, err = ibctesting.ParsePacketFromEvents(events.ToABCIEvents())
+	suite.Require().NoError(err)
+
+	ctx := suite.chainB.GetContext()
+	suite.Require().Equal(sdk.NewInt64Coin(voucherB, 300).String(), chainBApp.BankKeeper.GetBalance(ctx, signerB, voucherB).String())
+	suite.Require().Equal(sdk.NewInt64Coin(voucherB, 100).String(), chainBApp.BankKeeper.GetBalance(ctx, recipient, voucherB).String())
+	seq, err := chainBApp.OnionKeeper.GetSequence(ctx, signerB.String())
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), seq.Sequence)
+
+	// the second layer runs on chainC
+	ack, _ = suite.relay(suite.pathBC, packet)
+	suite.Require().True(ack.Success())
+
+	ctx = suite.chainC.GetContext()
+	suite.Require().Equal(sdk.NewInt64Coin(voucherC, 350).String(), chainCApp.BankKeeper.GetBalance(ctx, signerC, voucherC).String())
+	suite.Require().Equal(sdk.NewInt64Coin(voucherC, 250).String(), chainCApp.BankKeeper.GetBalance(ctx, recipient, voucherC).String())
+	seq, err = chainCApp.OnionKeeper.GetSequence(ctx, signerC.String())
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), seq.Sequence)
+}
+
+func (suite *RouteTestSuite) TestBuildRouteMemoInvalidHops() {
+	kr := keyring.NewInMemory(suite.onionApp(suite.chainA).AppCodec())
+	_, _, err := kr.NewMnemonic("b", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	suite.Require().NoError(err)
+	txConfig := suite.onionApp(suite.chainB).TxConfig()
+	lastHop := cli.RouteHop{ChainID: suite.chainC.ChainID, Key: "b", Msgs: []sdk.Msg{&banktypes.MsgSend{}}}
+
+	specs := map[string][]cli.RouteHop{
+		"no hops":          nil,
+		"hop without msgs": {{ChainID: suite.chainC.ChainID, Key: "b"}},
+		"unknown key":      {{ChainID: suite.chainC.ChainID, Key: "unknown", Msgs: []sdk.Msg{&banktypes.MsgSend{}}}},
+		"missing channel":  {{ChainID: suite.chainB.ChainID, Key: "b", Token: sdk.NewInt64Coin("stake", 1), TimeoutTimestamp: 1}, lastHop},
+		"missing token":    {{ChainID: suite.chainB.ChainID, Key: "b", Channel: "channel-0", TimeoutTimestamp: 1}, lastHop},
+		"missing timeout":  {{ChainID: suite.chainB.ChainID, Key: "b", Channel: "channel-0", Token: sdk.NewInt64Coin("stake", 1)}, lastHop},
+	}
+	for name, hops := range specs {
+		suite.Run(name, func() {
+			_, err := cli.BuildRouteMemo(context.Background(), txConfig, kr, hops)
+			suite.Require().Error(err)
+		})
+	}
+}
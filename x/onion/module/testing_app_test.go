@@ -0,0 +1,53 @@
+package onion_test
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	ibctestingtypes "github.com/cosmos/ibc-go/v8/testing/types"
+
+	app "onion/app"
+)
+
+func init() {
+	ibctesting.DefaultTestingAppInit = setupTestingApp
+}
+
+// testingApp adapts the onion app to the TestingApp interface of the ibc-go
+// testing package.
+type testingApp struct {
+	*app.App
+}
+
+var _ ibctesting.TestingApp = testingApp{}
+
+func (a testingApp) GetBaseApp() *baseapp.BaseApp {
+	return a.App.BaseApp
+}
+
+func (a testingApp) GetStakingKeeper() ibctestingtypes.StakingKeeper {
+	return a.StakingKeeper
+}
+
+func (a testingApp) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return a.ScopedIBCKeeper
+}
+
+func (a testingApp) GetTxConfig() client.TxConfig {
+	return a.TxConfig()
+}
+
+// setupTestingApp initializes a new onion app for the ibc-go testing package.
+func setupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	onionApp, err := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, make(simtestutil.AppOptionsMap, 0))
+	if err != nil {
+		panic(err)
+	}
+	return testingApp{onionApp}, onionApp.DefaultGenesis()
+}
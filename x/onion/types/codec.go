@@ -4,6 +4,7 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/types/tx"
 	// this line is used by starport scaffolding # 1
 )
 
@@ -12,6 +13,17 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgExecuteOnion{},
+		&MsgRetryOnion{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+
+	registry.RegisterImplementations((*tx.TxExtensionOptionI)(nil),
+		&ExtensionOptionDeadline{},
+		&ExtensionOptionUnorderedNonce{},
+		&ExtensionOptionRecovery{},
+		&ExtensionOptionPacketBinding{},
+		&ExtensionOptionReceivedAmount{},
+		&ExtensionOptionRetry{},
+	)
 }
@@ -8,7 +8,17 @@ import (
 
 // x/onion module sentinel errors
 var (
-	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-    ErrSample        = sdkerrors.Register(ModuleName, 1101, "sample error")
-	
+	ErrInvalidSigner    = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrSample           = sdkerrors.Register(ModuleName, 1101, "sample error")
+	ErrInvalidMemo      = sdkerrors.Register(ModuleName, 1102, "invalid onion memo")
+	ErrTxDecode         = sdkerrors.Register(ModuleName, 1103, "onion tx decoding failed")
+	ErrAnteFailed       = sdkerrors.Register(ModuleName, 1104, "onion tx ante check failed")
+	ErrExecuteFailed    = sdkerrors.Register(ModuleName, 1105, "onion tx execution failed")
+	ErrInvalidParams    = sdkerrors.Register(ModuleName, 1106, "invalid onion params")
+	ErrMsgNotAllowed    = sdkerrors.Register(ModuleName, 1107, "message type not allowed in onion tx")
+	ErrTxExpired        = sdkerrors.Register(ModuleName, 1108, "onion tx expired")
+	ErrQueuedTxNotFound = sdkerrors.Register(ModuleName, 1109, "queued onion tx not found")
+	ErrPacketBinding    = sdkerrors.Register(ModuleName, 1110, "onion tx packet binding not satisfied")
+	ErrSpendLimit       = sdkerrors.Register(ModuleName, 1111, "onion tx spent more than the packet delivered")
+	ErrReceivedAmount   = sdkerrors.Register(ModuleName, 1112, "onion tx amount placeholder not satisfied")
 )
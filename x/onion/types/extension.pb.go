@@ -0,0 +1,1187 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: onion/onion/extension.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ExtensionOptionDeadline is a tx extension option of an onion tx. The tx is
+// rejected once the block time of the executing chain has passed deadline.
+// Unlike the deadline of the memo envelope it is covered by the signature.
+type ExtensionOptionDeadline struct {
+	Deadline time.Time `protobuf:"bytes,1,opt,name=deadline,proto3,stdtime" json:"deadline"`
+}
+
+func (m *ExtensionOptionDeadline) Reset()         { *m = ExtensionOptionDeadline{} }
+func (m *ExtensionOptionDeadline) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionDeadline) ProtoMessage()    {}
+func (*ExtensionOptionDeadline) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8dd959ceb18cce02, []int{0}
+}
+func (m *ExtensionOptionDeadline) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionDeadline) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionDeadline.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionDeadline) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionDeadline.Merge(m, src)
+}
+func (m *ExtensionOptionDeadline) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionDeadline) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionDeadline.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionDeadline proto.InternalMessageInfo
+
+func (m *ExtensionOptionDeadline) GetDeadline() time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return time.Time{}
+}
+
+// ExtensionOptionUnorderedNonce is a tx extension option of an onion tx. It
+// makes the signature sequences nonces that may be used in any order instead
+// of the strictly increasing onion sequence of the signers.
+type ExtensionOptionUnorderedNonce struct {
+}
+
+func (m *ExtensionOptionUnorderedNonce) Reset()         { *m = ExtensionOptionUnorderedNonce{} }
+func (m *ExtensionOptionUnorderedNonce) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionUnorderedNonce) ProtoMessage()    {}
+func (*ExtensionOptionUnorderedNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8dd959ceb18cce02, []int{1}
+}
+func (m *ExtensionOptionUnorderedNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionUnorderedNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionUnorderedNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionUnorderedNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionUnorderedNonce.Merge(m, src)
+}
+func (m *ExtensionOptionUnorderedNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionUnorderedNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionUnorderedNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionUnorderedNonce proto.InternalMessageInfo
+
+// ExtensionOptionRecovery is a tx extension option of an onion tx carried by
+// an ICS-20 packet. When the tx fails, the funds the packet delivered are
+// moved from the receiver to address or, with return_receiver, transferred
+// back over the channel the packet arrived on. Exactly one of them is set.
+// The receiver of the packet must sign the tx, nothing but the delivered
+// amount of the received denom is ever moved. The signature of the receiver
+// must claim its current onion sequence, or an unused nonce, which is used
+// up when the tx failed before its messages ran, so the instruction of an
+// old memo cannot be replayed to redirect later transfers.
+type ExtensionOptionRecovery struct {
+	// address on this chain the funds are sent to.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// return_receiver is the address on the source chain the funds are
+	// transferred back to.
+	ReturnReceiver string `protobuf:"bytes,2,opt,name=return_receiver,json=returnReceiver,proto3" json:"return_receiver,omitempty"`
+	// return_timeout is the timeout of the return transfer in nanoseconds
+	// relative to the block time, the ICS-20 default when zero.
+	ReturnTimeout uint64 `protobuf:"varint,3,opt,name=return_timeout,json=returnTimeout,proto3" json:"return_timeout,omitempty"`
+}
+
+func (m *ExtensionOptionRecovery) Reset()         { *m = ExtensionOptionRecovery{} }
+func (m *ExtensionOptionRecovery) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionRecovery) ProtoMessage()    {}
+func (*ExtensionOptionRecovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8dd959ceb18cce02, []int{2}
+}
+func (m *ExtensionOptionRecovery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionRecovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionRecovery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionRecovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionRecovery.Merge(m, src)
+}
+func (m *ExtensionOptionRecovery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionRecovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionRecovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionRecovery proto.InternalMessageInfo
+
+func (m *ExtensionOptionRecovery) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ExtensionOptionRecovery) GetReturnReceiver() string {
+	if m != nil {
+		return m.ReturnReceiver
+	}
+	return ""
+}
+
+func (m *ExtensionOptionRecovery) GetReturnTimeout() uint64 {
+	if m != nil {
+		return m.ReturnTimeout
+	}
+	return 0
+}
+
+// ExtensionOptionPacketBinding is a tx extension option of an onion tx that
+// ties its execution to the ICS-20 packet carrying it. Txs with the option
+// are rejected when they are not carried by a packet.
+type ExtensionOptionPacketBinding struct {
+	// receiver requires the receiver of the packet to be the only signer of
+	// the tx.
+	Receiver bool `protobuf:"varint,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// spend_limit fails the execution when the balances of the signers,
+	// fees included, decreased by more than the packet delivered or in any
+	// other denom.
+	SpendLimit bool `protobuf:"varint,2,opt,name=spend_limit,json=spendLimit,proto3" json:"spend_limit,omitempty"`
+}
+
+func (m *ExtensionOptionPacketBinding) Reset()         { *m = ExtensionOptionPacketBinding{} }
+func (m *ExtensionOptionPacketBinding) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionPacketBinding) ProtoMessage()    {}
+func (*ExtensionOptionPacketBinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8dd959ceb18cce02, []int{3}
+}
+func (m *ExtensionOptionPacketBinding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionPacketBinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionPacketBinding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionPacketBinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionPacketBinding.Merge(m, src)
+}
+func (m *ExtensionOptionPacketBinding) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionPacketBinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionPacketBinding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionPacketBinding proto.InternalMessageInfo
+
+func (m *ExtensionOptionPacketBinding) GetReceiver() bool {
+	if m != nil {
+		return m.Receiver
+	}
+	return false
+}
+
+func (m *ExtensionOptionPacketBinding) GetSpendLimit() bool {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return false
+}
+
+// ExtensionOptionReceivedAmount is a tx extension option of an onion tx
+// carried by an ICS-20 packet. The coin of the tx messages in the placeholder
+// denom "onion/received", also within messages nested in Any fields like
+// those of an authz MsgExec, is replaced with the funds the packet delivered
+// before the messages are executed. The amount of the placeholder coin is the
+// minimum the packet must have delivered. Txs with the option are rejected
+// when they are not carried by a packet or when their messages have no
+// placeholder or more than one, as every placeholder would stand for all of
+// the delivered funds.
+type ExtensionOptionReceivedAmount struct {
+}
+
+func (m *ExtensionOptionReceivedAmount) Reset()         { *m = ExtensionOptionReceivedAmount{} }
+func (m *ExtensionOptionReceivedAmount) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionReceivedAmount) ProtoMessage()    {}
+func (*ExtensionOptionReceivedAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8dd959ceb18cce02, []int{4}
+}
+func (m *ExtensionOptionReceivedAmount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionReceivedAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionReceivedAmount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionReceivedAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionReceivedAmount.Merge(m, src)
+}
+func (m *ExtensionOptionReceivedAmount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionReceivedAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionReceivedAmount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionReceivedAmount proto.InternalMessageInfo
+
+// ExtensionOptionRetry is a tx extension option of an onion tx carried by an
+// ICS-20 packet. When the tx fails during execution it is kept in the retry
+// queue, if the chain enables it, and may be executed again with
+// MsgRetryOnion. Txs without the option are not queued.
+type ExtensionOptionRetry struct {
+}
+
+func (m *ExtensionOptionRetry) Reset()         { *m = ExtensionOptionRetry{} }
+func (m *ExtensionOptionRetry) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionRetry) ProtoMessage()    {}
+func (*ExtensionOptionRetry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8dd959ceb18cce02, []int{5}
+}
+func (m *ExtensionOptionRetry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionRetry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionRetry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionRetry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionRetry.Merge(m, src)
+}
+func (m *ExtensionOptionRetry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionRetry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionRetry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionRetry proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*ExtensionOptionDeadline)(nil), "onion.onion.ExtensionOptionDeadline")
+	proto.RegisterType((*ExtensionOptionUnorderedNonce)(nil), "onion.onion.ExtensionOptionUnorderedNonce")
+	proto.RegisterType((*ExtensionOptionRecovery)(nil), "onion.onion.ExtensionOptionRecovery")
+	proto.RegisterType((*ExtensionOptionPacketBinding)(nil), "onion.onion.ExtensionOptionPacketBinding")
+	proto.RegisterType((*ExtensionOptionReceivedAmount)(nil), "onion.onion.ExtensionOptionReceivedAmount")
+	proto.RegisterType((*ExtensionOptionRetry)(nil), "onion.onion.ExtensionOptionRetry")
+}
+
+func init() { proto.RegisterFile("onion/onion/extension.proto", fileDescriptor_8dd959ceb18cce02) }
+
+var fileDescriptor_8dd959ceb18cce02 = []byte{
+	// 363 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x41, 0x4f, 0xa3, 0x40,
+	0x14, 0xc7, 0x99, 0xdd, 0xcd, 0x2e, 0x3b, 0xcd, 0xee, 0x26, 0x6c, 0xa3, 0x04, 0x15, 0x1a, 0x12,
+	0x63, 0x2f, 0x42, 0xa2, 0x5f, 0x40, 0x1b, 0xbd, 0x19, 0x35, 0xa4, 0x5e, 0xec, 0xa1, 0xa1, 0xcc,
+	0x93, 0x4c, 0x2c, 0x33, 0x64, 0x18, 0x9a, 0xf6, 0xec, 0x17, 0xe8, 0xc7, 0xea, 0xb1, 0x47, 0x4f,
+	0x6a, 0xda, 0x2f, 0x62, 0x98, 0x81, 0x1e, 0x5a, 0x2f, 0x2f, 0xef, 0xfd, 0xf2, 0xe7, 0xff, 0xfe,
+	0xc0, 0xc3, 0x07, 0x9c, 0x51, 0xce, 0x42, 0x5d, 0x61, 0x2a, 0x81, 0x15, 0x94, 0xb3, 0x20, 0x17,
+	0x5c, 0x72, 0xab, 0xa5, 0x70, 0xa0, 0xaa, 0xd3, 0x4e, 0x79, 0xca, 0x15, 0x0f, 0xab, 0x4e, 0x4b,
+	0x1c, 0x2f, 0xe5, 0x3c, 0x1d, 0x43, 0xa8, 0xa6, 0x51, 0xf9, 0x14, 0x4a, 0x9a, 0x41, 0x21, 0xe3,
+	0x2c, 0xd7, 0x02, 0x7f, 0x80, 0xf7, 0xaf, 0x1b, 0xdb, 0xbb, 0x5c, 0x52, 0xce, 0xae, 0x20, 0x26,
+	0x63, 0xca, 0xc0, 0xba, 0xc0, 0x26, 0xa9, 0x7b, 0x1b, 0x75, 0x50, 0xb7, 0x75, 0xe6, 0x04, 0xda,
+	0x2e, 0x68, 0xec, 0x82, 0x7e, 0x63, 0xd7, 0x33, 0x17, 0x6f, 0x9e, 0x31, 0x7f, 0xf7, 0x50, 0xb4,
+	0x79, 0xca, 0xf7, 0xf0, 0xd1, 0x96, 0xf9, 0x03, 0xe3, 0x82, 0x80, 0x00, 0x72, 0xcb, 0x59, 0x02,
+	0xfe, 0x0b, 0xda, 0x59, 0x1f, 0x41, 0xc2, 0x27, 0x20, 0x66, 0x96, 0x8d, 0x7f, 0xc5, 0x84, 0x08,
+	0x28, 0x0a, 0xb5, 0xfd, 0x77, 0xd4, 0x8c, 0xd6, 0x09, 0xfe, 0x27, 0x40, 0x96, 0x82, 0x0d, 0x05,
+	0x24, 0x40, 0x27, 0x20, 0xec, 0x6f, 0x4a, 0xf1, 0x57, 0xe3, 0xa8, 0xa6, 0xd6, 0x31, 0xae, 0xc9,
+	0xb0, 0x7a, 0x6d, 0x5e, 0x4a, 0xfb, 0x7b, 0x07, 0x75, 0x7f, 0x44, 0x7f, 0x34, 0xed, 0x6b, 0xe8,
+	0x0f, 0xf0, 0xe1, 0x56, 0x88, 0xfb, 0x38, 0x79, 0x06, 0xd9, 0xa3, 0x8c, 0x50, 0x96, 0x5a, 0x0e,
+	0x36, 0x37, 0x8b, 0xaa, 0x28, 0x66, 0xb4, 0x99, 0x2d, 0x0f, 0xb7, 0x8a, 0x1c, 0x18, 0x19, 0x8e,
+	0x69, 0x46, 0xa5, 0xca, 0x61, 0x46, 0x58, 0xa1, 0x9b, 0x8a, 0x7c, 0xf1, 0x0d, 0xea, 0x78, 0xe4,
+	0x32, 0xe3, 0x25, 0x93, 0xfe, 0x1e, 0x6e, 0xef, 0x08, 0xa4, 0x98, 0xf5, 0x4e, 0x17, 0x2b, 0x17,
+	0x2d, 0x57, 0x2e, 0xfa, 0x58, 0xb9, 0x68, 0xbe, 0x76, 0x8d, 0xe5, 0xda, 0x35, 0x5e, 0xd7, 0xae,
+	0xf1, 0xf8, 0x5f, 0x9f, 0xc3, 0xb4, 0x3e, 0x0b, 0x39, 0xcb, 0xa1, 0x18, 0xfd, 0x54, 0xff, 0xe4,
+	0xfc, 0x73, 0x00, 0x69, 0xb7, 0x34, 0x38, 0x32, 0x02, 0x00, 0x00,
+}
+
+func (m *ExtensionOptionDeadline) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionDeadline) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionDeadline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintExtension(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionOptionUnorderedNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionUnorderedNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionUnorderedNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionOptionRecovery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionRecovery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionRecovery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReturnTimeout != 0 {
+		i = encodeVarintExtension(dAtA, i, uint64(m.ReturnTimeout))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ReturnReceiver) > 0 {
+		i -= len(m.ReturnReceiver)
+		copy(dAtA[i:], m.ReturnReceiver)
+		i = encodeVarintExtension(dAtA, i, uint64(len(m.ReturnReceiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintExtension(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionOptionPacketBinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionPacketBinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionPacketBinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpendLimit {
+		i--
+		if m.SpendLimit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Receiver {
+		i--
+		if m.Receiver {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionOptionReceivedAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionReceivedAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionReceivedAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionOptionRetry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionRetry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionRetry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintExtension(dAtA []byte, offset int, v uint64) int {
+	offset -= sovExtension(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExtensionOptionDeadline) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovExtension(uint64(l))
+	return n
+}
+
+func (m *ExtensionOptionUnorderedNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ExtensionOptionRecovery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovExtension(uint64(l))
+	}
+	l = len(m.ReturnReceiver)
+	if l > 0 {
+		n += 1 + l + sovExtension(uint64(l))
+	}
+	if m.ReturnTimeout != 0 {
+		n += 1 + sovExtension(uint64(m.ReturnTimeout))
+	}
+	return n
+}
+
+func (m *ExtensionOptionPacketBinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Receiver {
+		n += 2
+	}
+	if m.SpendLimit {
+		n += 2
+	}
+	return n
+}
+
+func (m *ExtensionOptionReceivedAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ExtensionOptionRetry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovExtension(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozExtension(x uint64) (n int) {
+	return sovExtension(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExtensionOptionDeadline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionDeadline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionDeadline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExtension
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionOptionUnorderedNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionUnorderedNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionUnorderedNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionOptionRecovery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionRecovery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionRecovery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExtension
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReturnReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReturnTimeout", wireType)
+			}
+			m.ReturnTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReturnTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionOptionPacketBinding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionPacketBinding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionPacketBinding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Receiver = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SpendLimit = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionOptionReceivedAmount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionReceivedAmount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionReceivedAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionOptionRetry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExtension
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionRetry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionRetry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExtension(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthExtension
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipExtension(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowExtension
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowExtension
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthExtension
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupExtension
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthExtension
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthExtension        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowExtension          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupExtension = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,37 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CheckTxFee verifies the fee of an onion tx against the min_gas_prices
+// param. Every fee denom must be accepted and the fee must cover the minimum
+// price for the declared gas limit in at least one denom.
+func (p Params) CheckTxFee(feeTx sdk.FeeTx) error {
+	minGasPrices := p.MinGasPrices
+	if minGasPrices.IsZero() {
+		return nil
+	}
+
+	fee := feeTx.GetFee()
+	for _, coin := range fee {
+		if minGasPrices.AmountOf(coin.Denom).IsZero() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "fee denom %s is not accepted for onion txs", coin.Denom)
+		}
+	}
+
+	// fee = ceil(minGasPrice * gasLimit)
+	requiredFees := make(sdk.Coins, len(minGasPrices))
+	glDec := sdkmath.LegacyNewDec(int64(feeTx.GetGas()))
+	for i, gp := range minGasPrices {
+		requiredFees[i] = sdk.NewCoin(gp.Denom, gp.Amount.Mul(glDec).Ceil().RoundInt())
+	}
+
+	if !fee.IsAnyGTE(requiredFees) {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", fee, requiredFees)
+	}
+	return nil
+}
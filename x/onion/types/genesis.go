@@ -1,10 +1,15 @@
 package types
 
+import "fmt"
+
 // DefaultGenesis returns the default GenesisState for the concentrated-liquidity module.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:    DefaultParams(),
-		Sequences: []OnionSequence{},
+		Params:            DefaultParams(),
+		Sequences:         []OnionSequence{},
+		UnorderedNonces:   []UnorderedNonces{},
+		ExecutionReceipts: []ExecutionReceipt{},
+		QueuedTxs:         []QueuedOnionTx{},
 	}
 }
 
@@ -13,5 +18,39 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+
+	seen := make(map[string]struct{}, len(gs.UnorderedNonces))
+	for _, nonces := range gs.UnorderedNonces {
+		if err := nonces.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[nonces.Address]; ok {
+			return fmt.Errorf("duplicate unordered nonces for %s", nonces.Address)
+		}
+		seen[nonces.Address] = struct{}{}
+	}
+
+	seenReceipts := make(map[string]struct{}, len(gs.ExecutionReceipts))
+	for _, receipt := range gs.ExecutionReceipts {
+		key := string(ExecutionReceiptKey(receipt.PortId, receipt.ChannelId, receipt.PacketSequence))
+		if _, ok := seenReceipts[key]; ok {
+			return fmt.Errorf("duplicate execution receipt for %s/%s/%d", receipt.PortId, receipt.ChannelId, receipt.PacketSequence)
+		}
+		seenReceipts[key] = struct{}{}
+	}
+
+	seenIDs := make(map[uint64]struct{}, len(gs.QueuedTxs))
+	for _, queued := range gs.QueuedTxs {
+		if queued.Id == 0 {
+			return fmt.Errorf("queued onion tx id must be positive")
+		}
+		if queued.Id >= gs.NextQueuedTxId {
+			return fmt.Errorf("queued onion tx id %d is not below the next id %d", queued.Id, gs.NextQueuedTxId)
+		}
+		if _, ok := seenIDs[queued.Id]; ok {
+			return fmt.Errorf("duplicate queued onion tx id %d", queued.Id)
+		}
+		seenIDs[queued.Id] = struct{}{}
+	}
 	return nil
 }
@@ -26,8 +26,17 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Params    Params          `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Sequences []OnionSequence `protobuf:"bytes,2,rep,name=sequences,proto3" json:"sequences"`
+	Params          Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Sequences       []OnionSequence   `protobuf:"bytes,2,rep,name=sequences,proto3" json:"sequences"`
+	UnorderedNonces []UnorderedNonces `protobuf:"bytes,3,rep,name=unordered_nonces,json=unorderedNonces,proto3" json:"unordered_nonces"`
+	// execution_receipts are the stored receipts of received packets, pruned
+	// by height as usual after the import.
+	ExecutionReceipts []ExecutionReceipt `protobuf:"bytes,4,rep,name=execution_receipts,json=executionReceipts,proto3" json:"execution_receipts"`
+	// queued_txs are the onion txs kept in the retry queue.
+	QueuedTxs []QueuedOnionTx `protobuf:"bytes,5,rep,name=queued_txs,json=queuedTxs,proto3" json:"queued_txs"`
+	// next_queued_tx_id is the id the next queued onion tx gets, it is above
+	// the ids of queued_txs. Zero starts the ids at 1.
+	NextQueuedTxId uint64 `protobuf:"varint,6,opt,name=next_queued_tx_id,json=nextQueuedTxId,proto3" json:"next_queued_tx_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,9 +86,40 @@ func (m *GenesisState) GetSequences() []OnionSequence {
 	return nil
 }
 
+func (m *GenesisState) GetUnorderedNonces() []UnorderedNonces {
+	if m != nil {
+		return m.UnorderedNonces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExecutionReceipts() []ExecutionReceipt {
+	if m != nil {
+		return m.ExecutionReceipts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueuedTxs() []QueuedOnionTx {
+	if m != nil {
+		return m.QueuedTxs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextQueuedTxId() uint64 {
+	if m != nil {
+		return m.NextQueuedTxId
+	}
+	return 0
+}
+
 type OnionSequence struct {
 	Address  string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// last_used_height is the height of the last onion tx signed by address,
+	// in strict or unordered mode.
+	LastUsedHeight int64 `protobuf:"varint,3,opt,name=last_used_height,json=lastUsedHeight,proto3" json:"last_used_height,omitempty"`
 }
 
 func (m *OnionSequence) Reset()         { *m = OnionSequence{} }
@@ -129,32 +169,115 @@ func (m *OnionSequence) GetSequence() uint64 {
 	return 0
 }
 
+func (m *OnionSequence) GetLastUsedHeight() int64 {
+	if m != nil {
+		return m.LastUsedHeight
+	}
+	return 0
+}
+
+// UnorderedNonces tracks the nonces an address has used for onion txs in
+// unordered mode. bitmap covers the window of nonces ending at highest, bit i
+// is set when nonce highest - i has been used.
+type UnorderedNonces struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Highest uint64 `protobuf:"varint,2,opt,name=highest,proto3" json:"highest,omitempty"`
+	Bitmap  []byte `protobuf:"bytes,3,opt,name=bitmap,proto3" json:"bitmap,omitempty"`
+}
+
+func (m *UnorderedNonces) Reset()         { *m = UnorderedNonces{} }
+func (m *UnorderedNonces) String() string { return proto.CompactTextString(m) }
+func (*UnorderedNonces) ProtoMessage()    {}
+func (*UnorderedNonces) Descriptor() ([]byte, []int) {
+	return fileDescriptor_68db73a797f7cb4a, []int{2}
+}
+func (m *UnorderedNonces) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnorderedNonces) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnorderedNonces.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnorderedNonces) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnorderedNonces.Merge(m, src)
+}
+func (m *UnorderedNonces) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnorderedNonces) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnorderedNonces.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnorderedNonces proto.InternalMessageInfo
+
+func (m *UnorderedNonces) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *UnorderedNonces) GetHighest() uint64 {
+	if m != nil {
+		return m.Highest
+	}
+	return 0
+}
+
+func (m *UnorderedNonces) GetBitmap() []byte {
+	if m != nil {
+		return m.Bitmap
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "onion.onion.GenesisState")
 	proto.RegisterType((*OnionSequence)(nil), "onion.onion.OnionSequence")
+	proto.RegisterType((*UnorderedNonces)(nil), "onion.onion.UnorderedNonces")
 }
 
 func init() { proto.RegisterFile("onion/onion/genesis.proto", fileDescriptor_68db73a797f7cb4a) }
 
 var fileDescriptor_68db73a797f7cb4a = []byte{
-	// 259 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcc, 0xcf, 0xcb, 0xcc,
-	0xcf, 0xd3, 0x87, 0x90, 0xe9, 0xa9, 0x79, 0xa9, 0xc5, 0x99, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25,
-	0xf9, 0x42, 0xdc, 0x60, 0x41, 0x3d, 0x30, 0x29, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f,
-	0x26, 0x21, 0xf2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x15, 0x95,
-	0x4c, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0x8e, 0x87, 0x48, 0x40, 0x38, 0x50, 0x29, 0x09, 0x64, 0xbb,
-	0x0a, 0x12, 0x8b, 0x12, 0x73, 0xa1, 0x32, 0x4a, 0x8d, 0x8c, 0x5c, 0x3c, 0xee, 0x10, 0xcb, 0x83,
-	0x4b, 0x12, 0x4b, 0x52, 0x85, 0x0c, 0xb9, 0xd8, 0x20, 0x0a, 0x24, 0x18, 0x15, 0x18, 0x35, 0xb8,
-	0x8d, 0x84, 0xf5, 0x90, 0x1c, 0xa3, 0x17, 0x00, 0x96, 0x72, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21,
-	0x08, 0xaa, 0x50, 0xc8, 0x8e, 0x8b, 0xb3, 0x38, 0xb5, 0xb0, 0x34, 0x35, 0x2f, 0x39, 0xb5, 0x58,
-	0x82, 0x49, 0x81, 0x59, 0x83, 0xdb, 0x48, 0x0a, 0x45, 0x97, 0x3f, 0x88, 0x0c, 0x86, 0x2a, 0x81,
-	0x6a, 0x46, 0x68, 0x51, 0x72, 0xe5, 0xe2, 0x45, 0x51, 0x21, 0x24, 0xc1, 0xc5, 0x9e, 0x98, 0x92,
-	0x52, 0x94, 0x5a, 0x0c, 0x71, 0x04, 0x67, 0x10, 0x8c, 0x2b, 0x24, 0xc5, 0xc5, 0x01, 0xd3, 0x27,
-	0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x12, 0x04, 0xe7, 0x3b, 0xe9, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1,
-	0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
-	0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x30, 0xc4, 0xe3, 0x15, 0xd0, 0x00, 0x28, 0xa9, 0x2c, 0x48, 0x2d,
-	0x4e, 0x62, 0x03, 0x07, 0x80, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xe0, 0xc1, 0xf1, 0x5e, 0x88,
-	0x01, 0x00, 0x00,
+	// 458 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0xeb, 0x90, 0xd2, 0x4d, 0x69, 0x9b, 0x2d, 0x82, 0x6d, 0x04, 0x26, 0xca, 0xc9, 0x1c,
+	0x48, 0x44, 0xb9, 0x83, 0x54, 0x09, 0x01, 0x07, 0x3e, 0xea, 0xb6, 0x17, 0x24, 0xb4, 0x72, 0xb3,
+	0x23, 0x67, 0x25, 0xb2, 0xeb, 0x7a, 0xd6, 0x92, 0xfb, 0x2f, 0xfa, 0xb3, 0x7a, 0xec, 0x91, 0x13,
+	0x42, 0xc9, 0x1f, 0x41, 0xde, 0x5d, 0x97, 0x3a, 0x42, 0xbd, 0x8c, 0x3c, 0xef, 0xbd, 0x79, 0xb3,
+	0x33, 0x1e, 0x72, 0xa0, 0x95, 0xd4, 0x6a, 0xea, 0x62, 0x06, 0x0a, 0x50, 0xe2, 0x24, 0x2f, 0xb4,
+	0xd1, 0xb4, 0x6f, 0xc1, 0x89, 0x8d, 0xc3, 0x41, 0xba, 0x90, 0x4a, 0x4f, 0x6d, 0x74, 0xfc, 0xf0,
+	0x71, 0xa6, 0x33, 0x6d, 0x3f, 0xa7, 0xf5, 0x97, 0x47, 0x0f, 0x66, 0x1a, 0x17, 0x1a, 0xb9, 0x23,
+	0x5c, 0xe2, 0x29, 0x76, 0xb7, 0x57, 0x9e, 0x16, 0xe9, 0xa2, 0x61, 0x5a, 0xaf, 0x28, 0x60, 0x06,
+	0x32, 0x37, 0x9e, 0x7a, 0xda, 0xa6, 0x4c, 0x71, 0xe9, 0x88, 0xf1, 0x55, 0x48, 0xb6, 0x3f, 0xb8,
+	0x07, 0x9f, 0x98, 0xd4, 0x00, 0x7d, 0x4d, 0x7a, 0xce, 0x94, 0x05, 0xa3, 0x20, 0xee, 0x1f, 0xee,
+	0x4f, 0xee, 0x0c, 0x30, 0xf9, 0x66, 0xa9, 0xa3, 0xee, 0xf5, 0xef, 0x17, 0x9d, 0xc4, 0x0b, 0xe9,
+	0x5b, 0xb2, 0x85, 0x70, 0x51, 0x82, 0x9a, 0x01, 0xb2, 0x8d, 0x51, 0x18, 0xf7, 0x0f, 0x87, 0xad,
+	0xaa, 0xaf, 0x75, 0x3c, 0xf1, 0x12, 0x5f, 0xfc, 0xaf, 0x84, 0x7e, 0x26, 0x7b, 0xa5, 0xd2, 0x85,
+	0x80, 0x02, 0x04, 0x57, 0xda, 0xda, 0x84, 0xd6, 0xe6, 0x59, 0xcb, 0xe6, 0xac, 0x11, 0x7d, 0xb1,
+	0x1a, 0x6f, 0xb4, 0x5b, 0xb6, 0x61, 0x9a, 0x10, 0x0a, 0x15, 0xcc, 0x4a, 0x23, 0xb5, 0xe2, 0x7e,
+	0x0d, 0xc8, 0xba, 0xd6, 0xf0, 0x79, 0xcb, 0xf0, 0x7d, 0x23, 0x4b, 0x9c, 0xca, 0x3b, 0x0e, 0x60,
+	0x0d, 0x47, 0xfa, 0x8e, 0x90, 0x8b, 0x12, 0x4a, 0x10, 0xdc, 0x54, 0xc8, 0x1e, 0xfc, 0x67, 0xc6,
+	0x63, 0x4b, 0xdb, 0x49, 0x4f, 0xab, 0x66, 0x46, 0x57, 0x73, 0x5a, 0x21, 0x7d, 0x49, 0x06, 0x0a,
+	0x2a, 0xc3, 0x6f, 0x5d, 0xb8, 0x14, 0xac, 0x37, 0x0a, 0xe2, 0x6e, 0xb2, 0x53, 0x13, 0xc7, 0x5e,
+	0xf9, 0x49, 0x8c, 0x35, 0x79, 0xd4, 0x5a, 0x18, 0x65, 0x64, 0x33, 0x15, 0xa2, 0x00, 0x74, 0xff,
+	0x64, 0x2b, 0x69, 0x52, 0x3a, 0x24, 0x0f, 0x9b, 0x35, 0xb2, 0x0d, 0x6b, 0x76, 0x9b, 0xd3, 0x98,
+	0xec, 0xfd, 0x4c, 0xd1, 0xf0, 0x12, 0x41, 0xf0, 0x39, 0xc8, 0x6c, 0x6e, 0x58, 0x38, 0x0a, 0xe2,
+	0x30, 0xd9, 0xa9, 0xf1, 0x33, 0x04, 0xf1, 0xd1, 0xa2, 0xe3, 0x1f, 0x64, 0x77, 0x6d, 0xb5, 0xf7,
+	0xb4, 0x64, 0x64, 0x73, 0x2e, 0xb3, 0x39, 0xa0, 0xf1, 0x1d, 0x9b, 0x94, 0x3e, 0x21, 0xbd, 0x73,
+	0x69, 0x16, 0x69, 0x6e, 0xdb, 0x6c, 0x27, 0x3e, 0x3b, 0x7a, 0x75, 0xbd, 0x8c, 0x82, 0x9b, 0x65,
+	0x14, 0xfc, 0x59, 0x46, 0xc1, 0xd5, 0x2a, 0xea, 0xdc, 0xac, 0xa2, 0xce, 0xaf, 0x55, 0xd4, 0xf9,
+	0xbe, 0xef, 0xee, 0xb1, 0xf2, 0x77, 0x69, 0x2e, 0x73, 0xc0, 0xf3, 0x9e, 0x3d, 0xcc, 0x37, 0x7f,
+	0x07, 0x00, 0x63, 0x03, 0x13, 0x47, 0x54, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -177,6 +300,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextQueuedTxId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextQueuedTxId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.QueuedTxs) > 0 {
+		for iNdEx := len(m.QueuedTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ExecutionReceipts) > 0 {
+		for iNdEx := len(m.ExecutionReceipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExecutionReceipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UnorderedNonces) > 0 {
+		for iNdEx := len(m.UnorderedNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnorderedNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Sequences) > 0 {
 		for iNdEx := len(m.Sequences) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -224,6 +394,11 @@ func (m *OnionSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastUsedHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastUsedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Sequence != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
 		i--
@@ -239,6 +414,48 @@ func (m *OnionSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UnorderedNonces) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnorderedNonces) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnorderedNonces) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bitmap) > 0 {
+		i -= len(m.Bitmap)
+		copy(dAtA[i:], m.Bitmap)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Bitmap)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Highest != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Highest))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -264,6 +481,27 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.UnorderedNonces) > 0 {
+		for _, e := range m.UnorderedNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExecutionReceipts) > 0 {
+		for _, e := range m.ExecutionReceipts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QueuedTxs) > 0 {
+		for _, e := range m.QueuedTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextQueuedTxId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextQueuedTxId))
+	}
 	return n
 }
 
@@ -280,6 +518,29 @@ func (m *OnionSequence) Size() (n int) {
 	if m.Sequence != 0 {
 		n += 1 + sovGenesis(uint64(m.Sequence))
 	}
+	if m.LastUsedHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.LastUsedHeight))
+	}
+	return n
+}
+
+func (m *UnorderedNonces) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Highest != 0 {
+		n += 1 + sovGenesis(uint64(m.Highest))
+	}
+	l = len(m.Bitmap)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -385,6 +646,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnorderedNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnorderedNonces = append(m.UnorderedNonces, UnorderedNonces{})
+			if err := m.UnorderedNonces[len(m.UnorderedNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionReceipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutionReceipts = append(m.ExecutionReceipts, ExecutionReceipt{})
+			if err := m.ExecutionReceipts[len(m.ExecutionReceipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedTxs = append(m.QueuedTxs, QueuedOnionTx{})
+			if err := m.QueuedTxs[len(m.QueuedTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextQueuedTxId", wireType)
+			}
+			m.NextQueuedTxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextQueuedTxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -486,6 +868,160 @@ func (m *OnionSequence) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsedHeight", wireType)
+			}
+			m.LastUsedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUsedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnorderedNonces) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnorderedNonces: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnorderedNonces: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Highest", wireType)
+			}
+			m.Highest = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Highest |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bitmap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bitmap = append(m.Bitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bitmap == nil {
+				m.Bitmap = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
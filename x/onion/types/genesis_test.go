@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"onion/x/onion/types"
 )
@@ -21,11 +22,77 @@ func TestGenesisState_Validate(t *testing.T) {
         {
             desc:     "valid genesis state",
             genState: &types.GenesisState{
-            	
+            	Params: types.DefaultParams(),
                 // this line is used by starport scaffolding # types/genesis/validField
             },
             valid:    true,
         },
+        {
+            desc:     "invalid params",
+            genState: &types.GenesisState{
+                Params: types.NewParams(types.EXECUTION_MODE_ATOMIC, 0, sdk.DecCoins{}, nil, nil, nil, false, 0, 0),
+            },
+            valid:    false,
+        },
+        {
+            desc:     "duplicate unordered nonces",
+            genState: &types.GenesisState{
+                Params: types.DefaultParams(),
+                UnorderedNonces: []types.UnorderedNonces{
+                    types.NewUnorderedNonces(sdk.AccAddress("addr1").String()),
+                    types.NewUnorderedNonces(sdk.AccAddress("addr1").String()),
+                },
+            },
+            valid:    false,
+        },
+        {
+            desc:     "invalid unordered nonces bitmap",
+            genState: &types.GenesisState{
+                Params: types.DefaultParams(),
+                UnorderedNonces: []types.UnorderedNonces{
+                    {Address: sdk.AccAddress("addr1").String(), Bitmap: []byte{1}},
+                },
+            },
+            valid:    false,
+        },
+        {
+            desc:     "queued txs below the next id",
+            genState: &types.GenesisState{
+                Params: types.DefaultParams(),
+                QueuedTxs: []types.QueuedOnionTx{{Id: 1}, {Id: 2}},
+                NextQueuedTxId: 3,
+            },
+            valid:    true,
+        },
+        {
+            desc:     "queued tx id not below the next id",
+            genState: &types.GenesisState{
+                Params: types.DefaultParams(),
+                QueuedTxs: []types.QueuedOnionTx{{Id: 3}},
+                NextQueuedTxId: 3,
+            },
+            valid:    false,
+        },
+        {
+            desc:     "duplicate queued tx id",
+            genState: &types.GenesisState{
+                Params: types.DefaultParams(),
+                QueuedTxs: []types.QueuedOnionTx{{Id: 1}, {Id: 1}},
+                NextQueuedTxId: 2,
+            },
+            valid:    false,
+        },
+        {
+            desc:     "duplicate execution receipt",
+            genState: &types.GenesisState{
+                Params: types.DefaultParams(),
+                ExecutionReceipts: []types.ExecutionReceipt{
+                    {PortId: "transfer", ChannelId: "channel-0", PacketSequence: 1},
+                    {PortId: "transfer", ChannelId: "channel-0", PacketSequence: 1},
+                },
+            },
+            valid:    false,
+        },
         // this line is used by starport scaffolding # types/genesis/testcase
 	}
 	for _, tc := range tests {
@@ -1,11 +1,23 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 const (
 	ModuleName = "onion"
 	RouterKey  = ModuleName
 	StoreKey   = ModuleName
 
-	OnionSequencePrefix = "onion-sequence"
+	OnionSequencePrefix        = "onion-sequence"
+	OnionUnorderedNoncesPrefix = "onion-unordered-nonces"
+
+	OnionExecutionReceiptPrefix   = "onion-execution-receipt"
+	OnionExecutionsBySignerPrefix = "onion-executions-by-signer"
+	OnionExecutionsByHeightPrefix = "onion-executions-by-height"
+
+	OnionQueuedTxPrefix      = "onion-queued-tx"
+	OnionQueueBySignerPrefix = "onion-queue-by-signer"
+	OnionQueueByExpiryPrefix = "onion-queue-by-expiry"
+	OnionQueueNextIDKey      = "onion-queue-next-id"
 )
 
 var (
@@ -16,4 +28,11 @@ func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
 
+// ExecutionReceiptKey returns the key of the execution receipt of a packet
+// within OnionExecutionReceiptPrefix. The by signer and by height indexes
+// append it to the signer and the big endian height respectively.
+func ExecutionReceiptKey(portID, channelID string, sequence uint64) []byte {
+	return append([]byte(portID+"/"+channelID+"/"), sdk.Uint64ToBigEndian(sequence)...)
+}
+
 const AccountNumber = ^uint64(0)
@@ -0,0 +1,128 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+const (
+	// MemoKey is the key of the onion envelope in a JSON ICS-20 memo. Other
+	// keys of the memo are left to other middlewares.
+	MemoKey = "onion"
+	// MemoVersion is the version of the onion envelope.
+	MemoVersion uint32 = 1
+)
+
+// OnionMemo is the onion envelope of an ICS-20 memo,
+// {"onion":{"tx":"<base64>","version":1}}.
+type OnionMemo struct {
+	// Tx is the base64 encoded onion tx.
+	Tx      string `json:"tx"`
+	Version uint32 `json:"version"`
+	// Deadline optionally bounds the block time at which the tx may be
+	// executed. It is chosen by the sender of the transfer and not covered by
+	// the onion tx signature, see ExtensionOptionDeadline for that.
+	Deadline *time.Time `json:"deadline,omitempty"`
+}
+
+// ParsedMemo is the onion payload extracted from an ICS-20 memo.
+type ParsedMemo struct {
+	TxBytes  []byte
+	Deadline *time.Time
+}
+
+// NewMemo returns a JSON ICS-20 memo carrying txBytes in the onion envelope.
+func NewMemo(txBytes []byte) (string, error) {
+	return newMemo(OnionMemo{
+		Tx:      base64.StdEncoding.EncodeToString(txBytes),
+		Version: MemoVersion,
+	})
+}
+
+// NewMemoWithDeadline is like NewMemo but sets the envelope deadline.
+func NewMemoWithDeadline(txBytes []byte, deadline time.Time) (string, error) {
+	deadline = deadline.UTC()
+	return newMemo(OnionMemo{
+		Tx:       base64.StdEncoding.EncodeToString(txBytes),
+		Version:  MemoVersion,
+		Deadline: &deadline,
+	})
+}
+
+func newMemo(onionMemo OnionMemo) (string, error) {
+	bz, err := json.Marshal(map[string]OnionMemo{MemoKey: onionMemo})
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+// NewLegacyMemo returns the raw base64 memo format that predates the JSON
+// envelope.
+func NewLegacyMemo(txBytes []byte) string {
+	return base64.StdEncoding.EncodeToString(txBytes)
+}
+
+// ParseMemo extracts the onion tx from an ICS-20 memo. found is false when
+// the memo does not address the onion module: a JSON object without the
+// onion key, or any other text unless allowLegacy is set and the text is a
+// strict base64 encoded raw tx. Plain text memos of regular transfers are not
+// found even when legacy memos are allowed.
+func ParseMemo(memo string, allowLegacy bool) (parsed ParsedMemo, found bool, err error) {
+	trimmed := strings.TrimSpace(memo)
+	if strings.HasPrefix(trimmed, "{") {
+		var envelope map[string]json.RawMessage
+		if err := json.Unmarshal([]byte(trimmed), &envelope); err == nil {
+			raw, ok := envelope[MemoKey]
+			if !ok {
+				return ParsedMemo{}, false, nil
+			}
+			parsed, err := decodeOnionMemo(raw)
+			return parsed, true, err
+		}
+	}
+
+	if !allowLegacy {
+		return ParsedMemo{}, false, nil
+	}
+	txBytes, err := base64.StdEncoding.Strict().DecodeString(trimmed)
+	if err != nil || !isRawTx(txBytes) {
+		return ParsedMemo{}, false, nil
+	}
+	return ParsedMemo{TxBytes: txBytes}, true, nil
+}
+
+// isRawTx reports whether bz decodes as a raw tx with a body and auth info,
+// as every signed tx has.
+func isRawTx(bz []byte) bool {
+	var raw txtypes.TxRaw
+	if err := raw.Unmarshal(bz); err != nil {
+		return false
+	}
+	return len(raw.BodyBytes) > 0 && len(raw.AuthInfoBytes) > 0
+}
+
+func decodeOnionMemo(raw json.RawMessage) (ParsedMemo, error) {
+	var onionMemo OnionMemo
+	decoder := json.NewDecoder(strings.NewReader(string(raw)))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&onionMemo); err != nil {
+		return ParsedMemo{}, fmt.Errorf("invalid onion envelope: %w", err)
+	}
+	if onionMemo.Version != MemoVersion {
+		return ParsedMemo{}, fmt.Errorf("unsupported onion envelope version %d", onionMemo.Version)
+	}
+	if onionMemo.Tx == "" {
+		return ParsedMemo{}, fmt.Errorf("onion envelope without tx")
+	}
+	txBytes, err := base64.StdEncoding.DecodeString(onionMemo.Tx)
+	if err != nil {
+		return ParsedMemo{}, err
+	}
+	return ParsedMemo{TxBytes: txBytes, Deadline: onionMemo.Deadline}, nil
+}
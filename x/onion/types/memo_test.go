@@ -0,0 +1,132 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/stretchr/testify/require"
+
+	"onion/x/onion/types"
+)
+
+func TestParseMemo(t *testing.T) {
+	txBytes := []byte("onion tx")
+	memo, err := types.NewMemo(txBytes)
+	require.NoError(t, err)
+	require.Equal(t, `{"onion":{"tx":"b25pb24gdHg=","version":1}}`, memo)
+
+	deadline := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	memoWithDeadline, err := types.NewMemoWithDeadline(txBytes, deadline)
+	require.NoError(t, err)
+	require.Equal(t, `{"onion":{"tx":"b25pb24gdHg=","version":1,"deadline":"2024-01-02T03:04:05Z"}}`, memoWithDeadline)
+
+	// legacy memos are only found when they carry a raw tx
+	rawTx := txtypes.TxRaw{BodyBytes: []byte("body"), AuthInfoBytes: []byte("auth info"), Signatures: [][]byte{[]byte("sig")}}
+	rawTxBytes, err := rawTx.Marshal()
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		memo        string
+		allowLegacy bool
+		expFound    bool
+		expTxBytes  []byte
+		expDeadline *time.Time
+		expErr      bool
+	}{
+		"envelope": {
+			memo:     memo,
+			expFound: true,
+		},
+		"envelope with other keys": {
+			memo:     `{"forward":{"port":"transfer"},"onion":{"tx":"b25pb24gdHg=","version":1}}`,
+			expFound: true,
+		},
+		"envelope with surrounding whitespace": {
+			memo:     " " + memo + "\n",
+			expFound: true,
+		},
+		"envelope with deadline": {
+			memo:        `{"onion":{"tx":"b25pb24gdHg=","version":1,"deadline":"2024-01-02T03:04:05Z"}}`,
+			expFound:    true,
+			expDeadline: &deadline,
+		},
+		"envelope with invalid deadline": {
+			memo:     `{"onion":{"tx":"b25pb24gdHg=","version":1,"deadline":"tomorrow"}}`,
+			expFound: true,
+			expErr:   true,
+		},
+		"other keys only": {
+			memo: `{"wasm":{"contract":"cosmos1"}}`,
+		},
+		"unsupported version": {
+			memo:     `{"onion":{"tx":"b25pb24gdHg=","version":2}}`,
+			expFound: true,
+			expErr:   true,
+		},
+		"unknown envelope field": {
+			memo:     `{"onion":{"tx":"b25pb24gdHg=","version":1,"foo":1}}`,
+			expFound: true,
+			expErr:   true,
+		},
+		"envelope without tx": {
+			memo:     `{"onion":{"version":1}}`,
+			expFound: true,
+			expErr:   true,
+		},
+		"legacy memo not allowed": {
+			memo: types.NewLegacyMemo(rawTxBytes),
+		},
+		"legacy memo allowed": {
+			memo:        types.NewLegacyMemo(rawTxBytes),
+			allowLegacy: true,
+			expFound:    true,
+			expTxBytes:  rawTxBytes,
+		},
+		"legacy memo with surrounding whitespace": {
+			memo:        " " + types.NewLegacyMemo(rawTxBytes) + "\n",
+			allowLegacy: true,
+			expFound:    true,
+			expTxBytes:  rawTxBytes,
+		},
+		"plain text with legacy memo allowed": {
+			memo:        "deposit 123",
+			allowLegacy: true,
+		},
+		"invalid json with legacy memo allowed": {
+			memo:        "{oops",
+			allowLegacy: true,
+		},
+		"empty memo with legacy memo allowed": {
+			memo:        "",
+			allowLegacy: true,
+		},
+		"base64 text that is not a tx with legacy memo allowed": {
+			memo:        types.NewLegacyMemo(txBytes),
+			allowLegacy: true,
+		},
+		"word that is valid base64 with legacy memo allowed": {
+			memo:        "test",
+			allowLegacy: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			parsed, found, err := types.ParseMemo(spec.memo, spec.allowLegacy)
+			require.Equal(t, spec.expFound, found)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			if spec.expFound {
+				expTxBytes := spec.expTxBytes
+				if expTxBytes == nil {
+					expTxBytes = txBytes
+				}
+				require.Equal(t, expTxBytes, parsed.TxBytes)
+				require.Equal(t, spec.expDeadline, parsed.Deadline)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ sdk.Msg = &MsgExecuteOnion{}
+
+// NewMsgExecuteOnion returns a MsgExecuteOnion submitting the onion tx
+// txBytes.
+func NewMsgExecuteOnion(submitter string, txBytes []byte) *MsgExecuteOnion {
+	return &MsgExecuteOnion{
+		Submitter: submitter,
+		TxBytes:   txBytes,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgExecuteOnion) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Submitter); err != nil {
+		return errorsmod.Wrap(err, "invalid submitter address")
+	}
+
+	if len(m.TxBytes) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "empty onion tx")
+	}
+
+	return nil
+}
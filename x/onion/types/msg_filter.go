@@ -0,0 +1,232 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	// msgTypeWildcard is the suffix of a msg type pattern matching every
+	// type URL with the preceding prefix.
+	msgTypeWildcard = "*"
+	// maxMsgDepth is the deepest level of nested messages an onion tx may
+	// contain, its top-level messages being at level 0.
+	maxMsgDepth = 8
+)
+
+var icaPacketDataType = reflect.TypeOf(icatypes.InterchainAccountPacketData{})
+
+// CheckMsgTypes rejects msgs containing a message type that is not allowed,
+// see IsMsgTypeAllowed. Messages nested in other messages, such as those of
+// an authz MsgExec, a gov or group proposal or an interchain account packet,
+// are checked as well. A nested type that cannot be resolved is checked by
+// its type URL alone.
+func (p Params) CheckMsgTypes(msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if err := p.checkMsgType(sdk.MsgTypeURL(msg), reflect.ValueOf(msg), 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p Params) checkMsgType(typeURL string, msg reflect.Value, depth int) error {
+	if !p.IsMsgTypeAllowed(typeURL) {
+		return errorsmod.Wrap(ErrMsgNotAllowed, typeURL)
+	}
+	return walkNestedMsgs(msg, func(typeURL string, nested reflect.Value) error {
+		if depth+1 > maxMsgDepth {
+			return errorsmod.Wrapf(ErrMsgNotAllowed, "messages nested deeper than %d levels", maxMsgDepth)
+		}
+		return p.checkMsgType(typeURL, nested, depth+1)
+	})
+}
+
+// walkNestedMsgs calls visit with every message packed in an Any reachable
+// from v, without descending into the messages found. The value passed for
+// a type that cannot be resolved is the zero reflect.Value.
+func walkNestedMsgs(v reflect.Value, visit func(typeURL string, msg reflect.Value) error) error {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		if v.Type() == reflect.PtrTo(anyType) {
+			return walkAny(v.Interface().(*codectypes.Any), visit)
+		}
+		return walkNestedMsgs(v.Elem(), visit)
+	case reflect.Struct:
+		switch v.Type() {
+		case anyType:
+			msgAny := v.Interface().(codectypes.Any)
+			return walkAny(&msgAny, visit)
+		case icaPacketDataType:
+			return walkICAPacketData(v.Interface().(icatypes.InterchainAccountPacketData), visit)
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
+			if err := walkNestedMsgs(v.Field(i), visit); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return nil
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := walkNestedMsgs(v.Index(i), visit); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// walkAny visits the value of msgAny when it is a message and otherwise
+// walks the messages nested in it.
+func walkAny(msgAny *codectypes.Any, visit func(string, reflect.Value) error) error {
+	value, ok := msgAny.GetCachedValue().(proto.Message)
+	if !ok {
+		var err error
+		if value, err = unpackAnyValue(msgAny); err != nil {
+			return visit(msgAny.TypeUrl, reflect.Value{})
+		}
+	}
+	if !isMsgType(msgAny.TypeUrl) {
+		return walkNestedMsgs(reflect.ValueOf(value), visit)
+	}
+	return visit(msgAny.TypeUrl, reflect.ValueOf(value))
+}
+
+// walkICAPacketData visits the messages of the interchain account tx in data,
+// encoded as protobuf or proto3 JSON.
+func walkICAPacketData(data icatypes.InterchainAccountPacketData, visit func(string, reflect.Value) error) error {
+	if len(data.Data) == 0 {
+		return nil
+	}
+	if json.Valid(data.Data) {
+		var jsonTx any
+		if err := json.Unmarshal(data.Data, &jsonTx); err != nil {
+			return errorsmod.Wrapf(ErrMsgNotAllowed, "interchain account tx: %s", err)
+		}
+		return walkJSONMsgs(jsonTx, visit)
+	}
+	var cosmosTx icatypes.CosmosTx
+	if err := proto.Unmarshal(data.Data, &cosmosTx); err != nil {
+		return errorsmod.Wrapf(ErrMsgNotAllowed, "interchain account tx: %s", err)
+	}
+	for _, msgAny := range cosmosTx.Messages {
+		if err := walkAny(msgAny, visit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// walkJSONMsgs visits every message found in v, decoded from proto3 JSON,
+// by its type URL alone.
+func walkJSONMsgs(v any, visit func(string, reflect.Value) error) error {
+	switch v := v.(type) {
+	case map[string]any:
+		if typeURL, ok := v["@type"].(string); ok && isMsgType(typeURL) {
+			if err := visit(typeURL, reflect.Value{}); err != nil {
+				return err
+			}
+		}
+		for _, field := range v {
+			if err := walkJSONMsgs(field, visit); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for _, elem := range v {
+			if err := walkJSONMsgs(elem, visit); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// unpackAnyValue decodes the value of msgAny with its registered type.
+func unpackAnyValue(msgAny *codectypes.Any) (proto.Message, error) {
+	name := msgAny.TypeUrl[strings.LastIndex(msgAny.TypeUrl, "/")+1:]
+	typ := proto.MessageType(name)
+	if typ == nil {
+		return nil, fmt.Errorf("unknown type %s", msgAny.TypeUrl)
+	}
+	value := reflect.New(typ.Elem()).Interface().(proto.Message)
+	if err := proto.Unmarshal(msgAny.Value, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// isMsgType reports whether typeURL names a message type with signers, as
+// opposed to a type such as a public key or an authorization. A type whose
+// descriptor is not found counts as a message.
+func isMsgType(typeURL string) bool {
+	name := typeURL[strings.LastIndex(typeURL, "/")+1:]
+	desc, err := proto.HybridResolver.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return true
+	}
+	msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+	return !ok || protov2.HasExtension(msgDesc.Options(), msgv1.E_Signer)
+}
+
+// IsMsgTypeAllowed reports whether an onion tx may contain a message of the
+// given type URL. Denied patterns take precedence over allowed ones and an
+// empty allowlist allows every type that is not denied.
+func (p Params) IsMsgTypeAllowed(typeURL string) bool {
+	if matchMsgType(p.DeniedMsgTypes, typeURL) {
+		return false
+	}
+	return len(p.AllowedMsgTypes) == 0 || matchMsgType(p.AllowedMsgTypes, typeURL)
+}
+
+func matchMsgType(patterns []string, typeURL string) bool {
+	for _, pattern := range patterns {
+		if prefix, ok := strings.CutSuffix(pattern, msgTypeWildcard); ok {
+			if strings.HasPrefix(typeURL, prefix) {
+				return true
+			}
+		} else if pattern == typeURL {
+			return true
+		}
+	}
+	return false
+}
+
+// validateMsgTypePatterns checks that every pattern is a type URL starting
+// with "/", optionally ending in a ".*" wildcard, and that none is repeated.
+func validateMsgTypePatterns(patterns []string) error {
+	seen := make(map[string]struct{}, len(patterns))
+	for _, pattern := range patterns {
+		if !strings.HasPrefix(pattern, "/") {
+			return fmt.Errorf("msg type %q must start with /", pattern)
+		}
+		name := strings.TrimSuffix(pattern, "."+msgTypeWildcard)
+		if len(name) <= 1 || strings.ContainsAny(name, msgTypeWildcard+" ") {
+			return fmt.Errorf("invalid msg type %q", pattern)
+		}
+		if _, ok := seen[pattern]; ok {
+			return fmt.Errorf("duplicate msg type %q", pattern)
+		}
+		seen[pattern] = struct{}{}
+	}
+	return nil
+}
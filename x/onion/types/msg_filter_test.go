@@ -0,0 +1,159 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	"github.com/cosmos/gogoproto/proto"
+	icacontrollertypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v8/modules/apps/27-interchain-accounts/types"
+	"github.com/stretchr/testify/require"
+
+	"onion/x/onion/types"
+)
+
+func TestParamsIsMsgTypeAllowed(t *testing.T) {
+	const (
+		msgSend      = "/cosmos.bank.v1beta1.MsgSend"
+		msgMultiSend = "/cosmos.bank.v1beta1.MsgMultiSend"
+		msgDelegate  = "/cosmos.staking.v1beta1.MsgDelegate"
+	)
+
+	specs := map[string]struct {
+		allowed    []string
+		denied     []string
+		expAllowed map[string]bool
+	}{
+		"no filters": {
+			expAllowed: map[string]bool{msgSend: true, msgMultiSend: true, msgDelegate: true},
+		},
+		"exact allowlist": {
+			allowed:    []string{msgSend},
+			expAllowed: map[string]bool{msgSend: true, msgMultiSend: false, msgDelegate: false},
+		},
+		"wildcard allowlist": {
+			allowed:    []string{"/cosmos.bank.*"},
+			expAllowed: map[string]bool{msgSend: true, msgMultiSend: true, msgDelegate: false},
+		},
+		"wildcard denylist": {
+			denied:     []string{"/cosmos.bank.*"},
+			expAllowed: map[string]bool{msgSend: false, msgMultiSend: false, msgDelegate: true},
+		},
+		"denylist takes precedence": {
+			allowed:    []string{"/cosmos.bank.*"},
+			denied:     []string{msgMultiSend},
+			expAllowed: map[string]bool{msgSend: true, msgMultiSend: false, msgDelegate: false},
+		},
+		"exact pattern does not match as prefix": {
+			denied:     []string{"/cosmos.bank.v1beta1.MsgSen"},
+			expAllowed: map[string]bool{msgSend: true},
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.AllowedMsgTypes = spec.allowed
+			params.DeniedMsgTypes = spec.denied
+			require.NoError(t, params.Validate())
+
+			for typeURL, exp := range spec.expAllowed {
+				require.Equal(t, exp, params.IsMsgTypeAllowed(typeURL), typeURL)
+			}
+		})
+	}
+}
+
+func TestParamsCheckMsgTypesNested(t *testing.T) {
+	from, to := sdk.AccAddress("from"), sdk.AccAddress("to")
+	send := &banktypes.MsgSend{FromAddress: from.String(), ToAddress: to.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("test", 1))}
+
+	exec := authz.NewMsgExec(from, []sdk.Msg{send})
+	nestedExec := authz.NewMsgExec(from, []sdk.Msg{&exec})
+	grant, err := authz.NewMsgGrant(from, to, authz.NewGenericAuthorization("/cosmos.bank.v1beta1.MsgSend"), nil)
+	require.NoError(t, err)
+	proposal, err := govv1.NewMsgSubmitProposal([]sdk.Msg{send}, nil, from.String(), "", "title", "summary", false)
+	require.NoError(t, err)
+	icaTx := func(encoding string) sdk.Msg {
+		data, err := icatypes.SerializeCosmosTx(codec.NewProtoCodec(interfaceRegistry()), []proto.Message{send}, encoding)
+		require.NoError(t, err)
+		return icacontrollertypes.NewMsgSendTx(from.String(), "connection-0", 0, icatypes.InterchainAccountPacketData{
+			Type: icatypes.EXECUTE_TX,
+			Data: data,
+		})
+	}
+
+	specs := map[string]struct {
+		msg     sdk.Msg
+		allowed []string
+		expErr  bool
+	}{
+		"authz exec": {
+			msg:    &exec,
+			expErr: true,
+		},
+		"authz exec in authz exec": {
+			msg:    &nestedExec,
+			expErr: true,
+		},
+		"gov proposal": {
+			msg:    proposal,
+			expErr: true,
+		},
+		"interchain account tx": {
+			msg:    icaTx(icatypes.EncodingProtobuf),
+			expErr: true,
+		},
+		"interchain account tx in proto3 json": {
+			msg:    icaTx(icatypes.EncodingProto3JSON),
+			expErr: true,
+		},
+		"authorization is not a message": {
+			msg:     grant,
+			allowed: []string{"/cosmos.authz.v1beta1.MsgGrant"},
+		},
+		"nested message not in allowlist": {
+			msg:     &exec,
+			allowed: []string{"/cosmos.authz.v1beta1.MsgExec"},
+			expErr:  true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.AllowedMsgTypes = spec.allowed
+			if spec.allowed == nil {
+				params.DeniedMsgTypes = []string{"/cosmos.bank.v1beta1.MsgSend"}
+			}
+			err := params.CheckMsgTypes([]sdk.Msg{spec.msg})
+			if spec.expErr {
+				require.ErrorIs(t, err, types.ErrMsgNotAllowed)
+				require.ErrorContains(t, err, "/cosmos.bank.v1beta1.MsgSend")
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParamsCheckMsgTypesDepth(t *testing.T) {
+	from := sdk.AccAddress("from")
+	var msg sdk.Msg = &banktypes.MsgSend{FromAddress: from.String(), ToAddress: from.String()}
+	for i := 0; i < 9; i++ {
+		exec := authz.NewMsgExec(from, []sdk.Msg{msg})
+		msg = &exec
+	}
+	err := types.DefaultParams().CheckMsgTypes([]sdk.Msg{msg})
+	require.ErrorIs(t, err, types.ErrMsgNotAllowed)
+	require.ErrorContains(t, err, "nested deeper")
+}
+
+func interfaceRegistry() codectypes.InterfaceRegistry {
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	return registry
+}
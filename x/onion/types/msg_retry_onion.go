@@ -0,0 +1,25 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgRetryOnion{}
+
+// NewMsgRetryOnion returns a MsgRetryOnion retrying the queued onion tx id.
+func NewMsgRetryOnion(sender string, id uint64) *MsgRetryOnion {
+	return &MsgRetryOnion{
+		Sender: sender,
+		Id:     id,
+	}
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRetryOnion) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	return nil
+}
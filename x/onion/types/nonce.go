@@ -0,0 +1,87 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// UnorderedNonceWindow is the number of nonces, counting down from the
+// highest nonce used by an address, that are tracked in unordered mode.
+// Nonces below the window are rejected.
+const UnorderedNonceWindow = 256
+
+// NewUnorderedNonces returns the nonce window of an address that has not used
+// any unordered nonce yet.
+func NewUnorderedNonces(address string) UnorderedNonces {
+	return UnorderedNonces{
+		Address: address,
+		Bitmap:  make([]byte, UnorderedNonceWindow/8),
+	}
+}
+
+// Check returns an error when nonce has already been used or is below the
+// window.
+func (n UnorderedNonces) Check(nonce uint64) error {
+	if nonce > n.Highest {
+		return nil
+	}
+	offset := n.Highest - nonce
+	if offset >= UnorderedNonceWindow {
+		return errorsmod.Wrapf(sdkerrors.ErrWrongSequence,
+			"unordered nonce %d is too old, highest used nonce is %d", nonce, n.Highest)
+	}
+	if n.isUsed(offset) {
+		return errorsmod.Wrapf(sdkerrors.ErrWrongSequence, "unordered nonce %d has already been used", nonce)
+	}
+	return nil
+}
+
+// Use marks nonce as used, sliding the window forward when nonce is higher
+// than any nonce used before.
+func (n *UnorderedNonces) Use(nonce uint64) error {
+	if err := n.Check(nonce); err != nil {
+		return err
+	}
+	if nonce > n.Highest {
+		n.slide(nonce - n.Highest)
+		n.Highest = nonce
+	}
+	n.setUsed(n.Highest-nonce, true)
+	return nil
+}
+
+// Validate checks the address and the bitmap size.
+func (n UnorderedNonces) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(n.Address); err != nil {
+		return err
+	}
+	if len(n.Bitmap) != UnorderedNonceWindow/8 {
+		return fmt.Errorf("unordered nonce bitmap of %s must have %d bytes, got %d",
+			n.Address, UnorderedNonceWindow/8, len(n.Bitmap))
+	}
+	return nil
+}
+
+func (n UnorderedNonces) isUsed(offset uint64) bool {
+	return n.Bitmap[offset/8]&(1<<(offset%8)) != 0
+}
+
+func (n *UnorderedNonces) setUsed(offset uint64, used bool) {
+	if used {
+		n.Bitmap[offset/8] |= 1 << (offset % 8)
+	} else {
+		n.Bitmap[offset/8] &^= 1 << (offset % 8)
+	}
+}
+
+// slide moves every tracked nonce by delta offsets, dropping the ones that
+// fall out of the window.
+func (n *UnorderedNonces) slide(delta uint64) {
+	for offset := uint64(UnorderedNonceWindow); offset > 0; offset-- {
+		i := offset - 1
+		n.setUsed(i, i >= delta && n.isUsed(i-delta))
+	}
+}
@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"onion/x/onion/types"
+)
+
+func TestUnorderedNonces(t *testing.T) {
+	nonces := types.NewUnorderedNonces(sdk.AccAddress("addr1").String())
+	require.NoError(t, nonces.Validate())
+
+	steps := []struct {
+		nonce  uint64
+		expErr bool
+	}{
+		{nonce: 0},
+		{nonce: 5},
+		{nonce: 3},
+		{nonce: 5, expErr: true},
+		{nonce: 0, expErr: true},
+		{nonce: 4},
+		{nonce: 300},
+		{nonce: 44, expErr: true},
+		{nonce: 45},
+		{nonce: 45, expErr: true},
+		{nonce: 5, expErr: true},
+		{nonce: 299},
+		{nonce: 1000},
+		{nonce: 300, expErr: true},
+		{nonce: 999},
+		{nonce: 1000, expErr: true},
+	}
+	for _, step := range steps {
+		err := nonces.Use(step.nonce)
+		if step.expErr {
+			require.Error(t, err, "nonce %d", step.nonce)
+		} else {
+			require.NoError(t, err, "nonce %d", step.nonce)
+		}
+	}
+	require.Equal(t, uint64(1000), nonces.Highest)
+	require.NoError(t, nonces.Validate())
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"context"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+type receivedFundsKey struct{}
+
+// NewReceivedFunds returns the funds packet carrying data delivered to its
+// receiver.
+func NewReceivedFunds(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) (ReceivedFunds, error) {
+	if _, err := sdk.AccAddressFromBech32(data.Receiver); err != nil {
+		return ReceivedFunds{}, fmt.Errorf("invalid packet receiver: %w", err)
+	}
+	coin, err := ReceivedCoin(packet, data)
+	if err != nil {
+		return ReceivedFunds{}, err
+	}
+	return ReceivedFunds{Receiver: data.Receiver, Coin: coin}, nil
+}
+
+// WithReceivedFunds returns a copy of ctx that exposes the funds the packet
+// carrying the onion tx delivered to the messages of the tx.
+func WithReceivedFunds(ctx sdk.Context, funds ReceivedFunds) sdk.Context {
+	return ctx.WithValue(receivedFundsKey{}, funds)
+}
+
+// ReceivedFundsFromContext returns the funds the packet carrying the onion tx
+// executed on ctx delivered, false if the tx was not carried by a packet.
+func ReceivedFundsFromContext(ctx context.Context) (ReceivedFunds, bool) {
+	funds, ok := ctx.Value(receivedFundsKey{}).(ReceivedFunds)
+	return funds, ok
+}
+
+// ReceivedCoin returns the coin the transfer module credited to the receiver
+// of packet carrying data, either the unwrapped denom of a token returning
+// to this chain or the ibc voucher denom.
+func ReceivedCoin(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) (sdk.Coin, error) {
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok {
+		return sdk.Coin{}, fmt.Errorf("invalid packet amount %q", data.Amount)
+	}
+
+	var denom string
+	if transfertypes.ReceiverChainIsSource(packet.SourcePort, packet.SourceChannel, data.Denom) {
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.SourcePort, packet.SourceChannel)
+		denomTrace := transfertypes.ParseDenomTrace(data.Denom[len(voucherPrefix):])
+		denom = denomTrace.BaseDenom
+		if !denomTrace.IsNativeDenom() {
+			denom = denomTrace.IBCDenom()
+		}
+	} else {
+		sourcePrefix := transfertypes.GetDenomPrefix(packet.DestinationPort, packet.DestinationChannel)
+		denom = transfertypes.ParseDenomTrace(sourcePrefix + data.Denom).IBCDenom()
+	}
+
+	coin := sdk.Coin{Denom: denom, Amount: amount}
+	if err := coin.Validate(); err != nil {
+		return sdk.Coin{}, err
+	}
+	return coin, nil
+}
@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"onion/x/onion/types"
+)
+
+func TestReceivedCoin(t *testing.T) {
+	packet := channeltypes.Packet{
+		SourcePort:         "transfer",
+		SourceChannel:      "channel-7",
+		DestinationPort:    "transfer",
+		DestinationChannel: "channel-0",
+	}
+	specs := map[string]struct {
+		denom    string
+		amount   string
+		expDenom string
+		expErr   bool
+	}{
+		"voucher": {
+			denom:    "uatom",
+			amount:   "100",
+			expDenom: transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom(),
+		},
+		"native returning": {
+			denom:    "transfer/channel-7/stake",
+			amount:   "100",
+			expDenom: "stake",
+		},
+		"voucher returning": {
+			denom:    "transfer/channel-7/transfer/channel-3/uosmo",
+			amount:   "100",
+			expDenom: transfertypes.ParseDenomTrace("transfer/channel-3/uosmo").IBCDenom(),
+		},
+		"invalid amount": {
+			denom:  "uatom",
+			amount: "1.5",
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			data := transfertypes.NewFungibleTokenPacketData(spec.denom, spec.amount, "sender", "receiver", "")
+			coin, err := types.ReceivedCoin(packet, data)
+			if spec.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, spec.expDenom, coin.Denom)
+			require.Equal(t, spec.amount, coin.Amount.String())
+		})
+	}
+}
@@ -1,7 +1,12 @@
 package types
 
 import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 )
 
 // Parameter store keys.
@@ -13,13 +18,56 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable()
 }
 
-func NewParams() Params {
-	return Params{}
+const (
+	// DefaultMaxGas is the default gas cap for a single onion tx.
+	DefaultMaxGas uint64 = 1_000_000
+	// DefaultReceiptRetentionBlocks keeps execution receipts for roughly a
+	// week at 6s blocks.
+	DefaultReceiptRetentionBlocks uint64 = 100_000
+	// DefaultRetryQueueBlocks disables the retry queue until governance
+	// enables it.
+	DefaultRetryQueueBlocks uint64 = 0
+)
+
+func NewParams(
+	executionMode ExecutionMode,
+	maxGas uint64,
+	minGasPrices sdk.DecCoins,
+	allowedMsgTypes, deniedMsgTypes []string,
+	enabledChannels []EnabledChannel,
+	legacyMemo bool,
+	receiptRetentionBlocks uint64,
+	retryQueueBlocks uint64,
+) Params {
+	return Params{
+		ExecutionMode:          executionMode,
+		MaxGas:                 maxGas,
+		MinGasPrices:           minGasPrices,
+		AllowedMsgTypes:        allowedMsgTypes,
+		DeniedMsgTypes:         deniedMsgTypes,
+		EnabledChannels:        enabledChannels,
+		LegacyMemo:             legacyMemo,
+		ReceiptRetentionBlocks: receiptRetentionBlocks,
+		RetryQueueBlocks:       retryQueueBlocks,
+	}
 }
 
-// DefaultParams returns default concentrated-liquidity module parameters.
+// DefaultParams returns default onion module parameters. No channel is
+// enabled by default, governance opts in the channels of trusted
+// counterparties.
 func DefaultParams() Params {
-	return Params{}
+	return NewParams(EXECUTION_MODE_BEST_EFFORT, DefaultMaxGas, nil, nil, nil, nil, false, DefaultReceiptRetentionBlocks, DefaultRetryQueueBlocks)
+}
+
+// IsChannelEnabled reports whether onion txs received on the given channel
+// end are executed.
+func (p Params) IsChannelEnabled(portID, channelID string) bool {
+	for _, channel := range p.EnabledChannels {
+		if channel.PortId == portID && channel.ChannelId == channelID {
+			return true
+		}
+	}
+	return false
 }
 
 // ParamSetPairs implements params.ParamSet.
@@ -29,5 +77,40 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 
 // Validate params.
 func (p Params) Validate() error {
+	if _, ok := ExecutionMode_name[int32(p.ExecutionMode)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidParams, "unknown execution mode %d", p.ExecutionMode)
+	}
+	if p.MaxGas == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "max gas must be positive")
+	}
+	if err := p.MinGasPrices.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid min gas prices: %s", err)
+	}
+	if err := validateMsgTypePatterns(p.AllowedMsgTypes); err != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid allowed msg types: %s", err)
+	}
+	if err := validateMsgTypePatterns(p.DeniedMsgTypes); err != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid denied msg types: %s", err)
+	}
+	if err := validateEnabledChannels(p.EnabledChannels); err != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid enabled channels: %s", err)
+	}
+	return nil
+}
+
+func validateEnabledChannels(channels []EnabledChannel) error {
+	seen := make(map[EnabledChannel]struct{}, len(channels))
+	for _, channel := range channels {
+		if err := host.PortIdentifierValidator(channel.PortId); err != nil {
+			return err
+		}
+		if err := host.ChannelIdentifierValidator(channel.ChannelId); err != nil {
+			return err
+		}
+		if _, ok := seen[channel]; ok {
+			return fmt.Errorf("duplicate channel %s/%s", channel.PortId, channel.ChannelId)
+		}
+		seen[channel] = struct{}{}
+	}
 	return nil
 }
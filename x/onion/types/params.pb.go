@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -24,8 +26,75 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ExecutionMode defines how the outcome of an onion tx is reflected in the
+// acknowledgement of the packet carrying it.
+type ExecutionMode int32
+
+const (
+	// EXECUTION_MODE_BEST_EFFORT acknowledges the transfer even when the onion
+	// tx fails, the transferred tokens stay with the receiver.
+	EXECUTION_MODE_BEST_EFFORT ExecutionMode = 0
+	// EXECUTION_MODE_ATOMIC returns an error acknowledgement when the onion tx
+	// fails, so the tokens are refunded on the source chain.
+	EXECUTION_MODE_ATOMIC ExecutionMode = 1
+)
+
+var ExecutionMode_name = map[int32]string{
+	0: "EXECUTION_MODE_BEST_EFFORT",
+	1: "EXECUTION_MODE_ATOMIC",
+}
+
+var ExecutionMode_value = map[string]int32{
+	"EXECUTION_MODE_BEST_EFFORT": 0,
+	"EXECUTION_MODE_ATOMIC":      1,
+}
+
+func (x ExecutionMode) String() string {
+	return proto.EnumName(ExecutionMode_name, int32(x))
+}
+
+func (ExecutionMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3abed499753b7141, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
+	// execution_mode defines how a failed onion tx affects the ICS-20 transfer
+	// carrying it.
+	ExecutionMode ExecutionMode `protobuf:"varint,1,opt,name=execution_mode,json=executionMode,proto3,enum=onion.onion.ExecutionMode" json:"execution_mode,omitempty"`
+	// max_gas caps the gas an onion tx may consume, the effective limit is the
+	// smaller of this value and the gas limit declared in the tx.
+	MaxGas uint64 `protobuf:"varint,2,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty"`
+	// min_gas_prices lists the fee denoms accepted for onion txs together with
+	// their minimum price per unit of gas. An empty list accepts any fee.
+	MinGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=min_gas_prices,json=minGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_gas_prices"`
+	// allowed_msg_types lists the message type URLs an onion tx may contain. A
+	// pattern ending in ".*" matches every type URL with that prefix, e.g.
+	// "/cosmos.bank.*". An empty list allows any message type. Messages nested
+	// in other messages, e.g. in an authz MsgExec, a gov or group proposal or
+	// an interchain account tx, must be allowed as well.
+	AllowedMsgTypes []string `protobuf:"bytes,4,rep,name=allowed_msg_types,json=allowedMsgTypes,proto3" json:"allowed_msg_types,omitempty"`
+	// denied_msg_types lists the message type URLs an onion tx must not
+	// contain, using the same patterns as allowed_msg_types. It takes
+	// precedence over allowed_msg_types.
+	DeniedMsgTypes []string `protobuf:"bytes,5,rep,name=denied_msg_types,json=deniedMsgTypes,proto3" json:"denied_msg_types,omitempty"`
+	// enabled_channels lists the channels on which received ICS-20 packets may
+	// carry an onion tx. Memos of packets received on any other channel are
+	// ignored, an empty list disables onion execution.
+	EnabledChannels []EnabledChannel `protobuf:"bytes,6,rep,name=enabled_channels,json=enabledChannels,proto3" json:"enabled_channels"`
+	// legacy_memo additionally accepts memos consisting of nothing but the
+	// base64 encoded onion tx. Without it only the JSON envelope
+	// {"onion":{"tx":"<base64>","version":1}} is recognized.
+	// Other text, such as the free text memo of a regular transfer, is
+	// ignored.
+	LegacyMemo bool `protobuf:"varint,7,opt,name=legacy_memo,json=legacyMemo,proto3" json:"legacy_memo,omitempty"`
+	// receipt_retention_blocks is the number of blocks an execution receipt is
+	// kept for before it is pruned. Zero disables execution receipts.
+	ReceiptRetentionBlocks uint64 `protobuf:"varint,8,opt,name=receipt_retention_blocks,json=receiptRetentionBlocks,proto3" json:"receipt_retention_blocks,omitempty"`
+	// retry_queue_blocks is the number of blocks an onion tx that failed
+	// during execution and opted in with ExtensionOptionRetry is kept in the
+	// retry queue for. Zero, the default, disables the retry queue.
+	RetryQueueBlocks uint64 `protobuf:"varint,9,opt,name=retry_queue_blocks,json=retryQueueBlocks,proto3" json:"retry_queue_blocks,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,23 +130,170 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetExecutionMode() ExecutionMode {
+	if m != nil {
+		return m.ExecutionMode
+	}
+	return EXECUTION_MODE_BEST_EFFORT
+}
+
+func (m *Params) GetMaxGas() uint64 {
+	if m != nil {
+		return m.MaxGas
+	}
+	return 0
+}
+
+func (m *Params) GetMinGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinGasPrices
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedMsgTypes() []string {
+	if m != nil {
+		return m.AllowedMsgTypes
+	}
+	return nil
+}
+
+func (m *Params) GetDeniedMsgTypes() []string {
+	if m != nil {
+		return m.DeniedMsgTypes
+	}
+	return nil
+}
+
+func (m *Params) GetEnabledChannels() []EnabledChannel {
+	if m != nil {
+		return m.EnabledChannels
+	}
+	return nil
+}
+
+func (m *Params) GetLegacyMemo() bool {
+	if m != nil {
+		return m.LegacyMemo
+	}
+	return false
+}
+
+func (m *Params) GetReceiptRetentionBlocks() uint64 {
+	if m != nil {
+		return m.ReceiptRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetRetryQueueBlocks() uint64 {
+	if m != nil {
+		return m.RetryQueueBlocks
+	}
+	return 0
+}
+
+// EnabledChannel identifies a channel end on this chain that accepts onion
+// txs.
+type EnabledChannel struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *EnabledChannel) Reset()         { *m = EnabledChannel{} }
+func (m *EnabledChannel) String() string { return proto.CompactTextString(m) }
+func (*EnabledChannel) ProtoMessage()    {}
+func (*EnabledChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3abed499753b7141, []int{1}
+}
+func (m *EnabledChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EnabledChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EnabledChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EnabledChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnabledChannel.Merge(m, src)
+}
+func (m *EnabledChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *EnabledChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnabledChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnabledChannel proto.InternalMessageInfo
+
+func (m *EnabledChannel) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *EnabledChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("onion.onion.ExecutionMode", ExecutionMode_name, ExecutionMode_value)
 	proto.RegisterType((*Params)(nil), "onion.onion.Params")
+	proto.RegisterType((*EnabledChannel)(nil), "onion.onion.EnabledChannel")
 }
 
 func init() { proto.RegisterFile("onion/onion/params.proto", fileDescriptor_3abed499753b7141) }
 
 var fileDescriptor_3abed499753b7141 = []byte{
-	// 144 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc8, 0xcf, 0xcb, 0xcc,
-	0xcf, 0xd3, 0x87, 0x90, 0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0xdc, 0x60, 0x31, 0x3d, 0x30, 0x29, 0x25, 0x98, 0x98, 0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26,
-	0x21, 0xf2, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55, 0x52,
-	0xe7, 0x62, 0x0b, 0x00, 0x9b, 0x62, 0x25, 0xfb, 0x62, 0x81, 0x3c, 0x63, 0xd7, 0xf3, 0x0d, 0x5a,
-	0x22, 0x10, 0xc3, 0x2b, 0xa0, 0x96, 0x40, 0xa4, 0x9d, 0x74, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0,
-	0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8,
-	0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x18, 0x55, 0x7d, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8,
-	0x78, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x62, 0xb1, 0x5d, 0x01, 0xb0, 0x00, 0x00, 0x00,
+	// 589 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x93, 0x41, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0xbb, 0x50, 0x0b, 0x1d, 0xa4, 0x94, 0x11, 0x75, 0xad, 0xb2, 0x6d, 0x38, 0x35, 0x55,
+	0x76, 0x03, 0x5e, 0x08, 0x37, 0x5a, 0x0a, 0xe9, 0xa1, 0x14, 0xd6, 0x9a, 0x18, 0x2f, 0x93, 0xe9,
+	0xee, 0xcb, 0xb2, 0xa1, 0x33, 0x53, 0x77, 0xb6, 0x5a, 0x12, 0x3f, 0x80, 0xf1, 0xe4, 0x47, 0x30,
+	0xf1, 0x62, 0x3c, 0x91, 0xf8, 0x25, 0x38, 0x72, 0xf4, 0xa4, 0x86, 0x1e, 0xf0, 0x63, 0x98, 0x9d,
+	0xd9, 0x1a, 0xd6, 0xcb, 0xec, 0xcc, 0xff, 0xf7, 0x7f, 0x79, 0xef, 0xcd, 0xbc, 0x45, 0xa6, 0xe0,
+	0xa1, 0xe0, 0x8e, 0x5e, 0x47, 0x34, 0xa2, 0x4c, 0xda, 0xa3, 0x48, 0xc4, 0x02, 0x2f, 0x29, 0xcd,
+	0x56, 0x6b, 0x65, 0x95, 0xb2, 0x90, 0x0b, 0x47, 0xad, 0x9a, 0x57, 0xd6, 0x02, 0x11, 0x08, 0xb5,
+	0x75, 0x92, 0x5d, 0xaa, 0x5a, 0x9e, 0x90, 0x4c, 0x48, 0x67, 0x40, 0x25, 0x38, 0x6f, 0xb7, 0x06,
+	0x10, 0xd3, 0x2d, 0xc7, 0x13, 0x21, 0xd7, 0x7c, 0xe3, 0x7b, 0x1e, 0x15, 0x8e, 0x55, 0x1a, 0xbc,
+	0x87, 0x4a, 0x30, 0x01, 0x6f, 0x1c, 0x87, 0x82, 0x13, 0x26, 0x7c, 0x30, 0x8d, 0x9a, 0x51, 0x2f,
+	0x6d, 0x57, 0xec, 0x5b, 0x99, 0xed, 0xf6, 0xcc, 0xd2, 0x15, 0x3e, 0xb8, 0xcb, 0x70, 0xfb, 0x88,
+	0x1f, 0xa2, 0x05, 0x46, 0x27, 0x24, 0xa0, 0xd2, 0x9c, 0xab, 0x19, 0xf5, 0xbc, 0x5b, 0x60, 0x74,
+	0x72, 0x48, 0x25, 0x7e, 0x8f, 0x4a, 0x2c, 0xe4, 0x09, 0x20, 0xa3, 0x28, 0xf4, 0x40, 0x9a, 0xf3,
+	0xb5, 0xf9, 0xfa, 0xd2, 0xf6, 0x13, 0x5b, 0xd7, 0x67, 0x27, 0xf5, 0xd9, 0x69, 0x7d, 0xf6, 0x3e,
+	0x78, 0x2d, 0x11, 0xf2, 0xe6, 0xce, 0xe5, 0xcf, 0x6a, 0xee, 0xdb, 0xaf, 0xea, 0xd3, 0x20, 0x8c,
+	0x4f, 0xc7, 0x03, 0xdb, 0x13, 0xcc, 0x49, 0xfb, 0xd1, 0x9f, 0x4d, 0xe9, 0x9f, 0x39, 0xf1, 0xf9,
+	0x08, 0xe4, 0x2c, 0x46, 0x7e, 0xbd, 0xb9, 0x68, 0x18, 0xee, 0x5d, 0x16, 0xf2, 0x43, 0x2a, 0x8f,
+	0x55, 0x2e, 0xdc, 0x40, 0xab, 0x74, 0x38, 0x14, 0xef, 0xc0, 0x27, 0x4c, 0x06, 0x44, 0x05, 0x98,
+	0xf9, 0xda, 0x7c, 0xbd, 0xe8, 0xae, 0xa4, 0xa0, 0x2b, 0x83, 0x7e, 0x22, 0xe3, 0x3a, 0x2a, 0xfb,
+	0xc0, 0xc3, 0x8c, 0xf5, 0x8e, 0xb2, 0x96, 0xb4, 0xfe, 0xcf, 0x79, 0x82, 0xca, 0xc0, 0xe9, 0x60,
+	0x08, 0x3e, 0xf1, 0x4e, 0x29, 0xe7, 0x30, 0x94, 0x66, 0x41, 0x75, 0xf5, 0x38, 0x7b, 0x63, 0xda,
+	0xd4, 0xd2, 0x9e, 0x66, 0x31, 0x69, 0x4a, 0x57, 0xb9, 0x02, 0x19, 0x24, 0x71, 0x15, 0x2d, 0x0d,
+	0x21, 0xa0, 0xde, 0x39, 0x61, 0xc0, 0x84, 0xb9, 0x50, 0x33, 0xea, 0x8b, 0x2e, 0xd2, 0x52, 0x17,
+	0x98, 0xc0, 0x3b, 0xc8, 0x8c, 0xc0, 0x83, 0x70, 0x14, 0x93, 0x08, 0x62, 0xe0, 0xea, 0xad, 0x06,
+	0x43, 0xe1, 0x9d, 0x49, 0x73, 0x51, 0xdd, 0xf8, 0x83, 0x94, 0xbb, 0x33, 0xdc, 0x54, 0x14, 0x3f,
+	0x43, 0x38, 0x82, 0x38, 0x3a, 0x27, 0x6f, 0xc6, 0x30, 0x86, 0x59, 0x4c, 0x51, 0xc5, 0x94, 0x15,
+	0x39, 0x49, 0x80, 0x76, 0xef, 0xae, 0xff, 0xf9, 0x5c, 0x35, 0x3e, 0xde, 0x5c, 0x34, 0xd6, 0xf4,
+	0x24, 0x4e, 0xd2, 0x89, 0xd4, 0xa3, 0xb2, 0x71, 0x84, 0x4a, 0xd9, 0xae, 0x92, 0x97, 0x1f, 0x89,
+	0x28, 0x26, 0xa1, 0xaf, 0xa6, 0xa6, 0xe8, 0x16, 0x92, 0x63, 0xc7, 0xc7, 0xeb, 0x08, 0xa5, 0xb7,
+	0x93, 0xb0, 0x39, 0xc5, 0x8a, 0xa9, 0xd2, 0xf1, 0x77, 0xf3, 0x49, 0xa2, 0xc6, 0x31, 0x5a, 0xce,
+	0xcc, 0x15, 0xb6, 0x50, 0xa5, 0xfd, 0xaa, 0xdd, 0x7a, 0xd9, 0xef, 0xf4, 0x8e, 0x48, 0xb7, 0xb7,
+	0xdf, 0x26, 0xcd, 0xf6, 0x8b, 0x3e, 0x69, 0x1f, 0x1c, 0xf4, 0xdc, 0x7e, 0x39, 0x87, 0x1f, 0xa1,
+	0xfb, 0xff, 0xf1, 0xbd, 0x7e, 0xaf, 0xdb, 0x69, 0x95, 0x8d, 0x4a, 0xfe, 0xc3, 0x17, 0x2b, 0xd7,
+	0xdc, 0xbc, 0xbc, 0xb6, 0x8c, 0xab, 0x6b, 0xcb, 0xf8, 0x7d, 0x6d, 0x19, 0x9f, 0xa6, 0x56, 0xee,
+	0x6a, 0x6a, 0xe5, 0x7e, 0x4c, 0xad, 0xdc, 0xeb, 0x7b, 0xd9, 0x8e, 0xd4, 0x0b, 0x0f, 0x0a, 0xea,
+	0x6f, 0x78, 0xfe, 0x77, 0x00, 0xa7, 0x4d, 0x4c, 0xc7, 0x7f, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -99,6 +315,80 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.ExecutionMode != that1.ExecutionMode {
+		return false
+	}
+	if this.MaxGas != that1.MaxGas {
+		return false
+	}
+	if len(this.MinGasPrices) != len(that1.MinGasPrices) {
+		return false
+	}
+	for i := range this.MinGasPrices {
+		if !this.MinGasPrices[i].Equal(&that1.MinGasPrices[i]) {
+			return false
+		}
+	}
+	if len(this.AllowedMsgTypes) != len(that1.AllowedMsgTypes) {
+		return false
+	}
+	for i := range this.AllowedMsgTypes {
+		if this.AllowedMsgTypes[i] != that1.AllowedMsgTypes[i] {
+			return false
+		}
+	}
+	if len(this.DeniedMsgTypes) != len(that1.DeniedMsgTypes) {
+		return false
+	}
+	for i := range this.DeniedMsgTypes {
+		if this.DeniedMsgTypes[i] != that1.DeniedMsgTypes[i] {
+			return false
+		}
+	}
+	if len(this.EnabledChannels) != len(that1.EnabledChannels) {
+		return false
+	}
+	for i := range this.EnabledChannels {
+		if !this.EnabledChannels[i].Equal(&that1.EnabledChannels[i]) {
+			return false
+		}
+	}
+	if this.LegacyMemo != that1.LegacyMemo {
+		return false
+	}
+	if this.ReceiptRetentionBlocks != that1.ReceiptRetentionBlocks {
+		return false
+	}
+	if this.RetryQueueBlocks != that1.RetryQueueBlocks {
+		return false
+	}
+	return true
+}
+func (this *EnabledChannel) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EnabledChannel)
+	if !ok {
+		that2, ok := that.(EnabledChannel)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.PortId != that1.PortId {
+		return false
+	}
+	if this.ChannelId != that1.ChannelId {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -121,6 +411,119 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RetryQueueBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RetryQueueBlocks))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ReceiptRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ReceiptRetentionBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.LegacyMemo {
+		i--
+		if m.LegacyMemo {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.EnabledChannels) > 0 {
+		for iNdEx := len(m.EnabledChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EnabledChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DeniedMsgTypes) > 0 {
+		for iNdEx := len(m.DeniedMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DeniedMsgTypes[iNdEx])
+			copy(dAtA[i:], m.DeniedMsgTypes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.DeniedMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AllowedMsgTypes) > 0 {
+		for iNdEx := len(m.AllowedMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedMsgTypes[iNdEx])
+			copy(dAtA[i:], m.AllowedMsgTypes[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MinGasPrices) > 0 {
+		for iNdEx := len(m.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.MaxGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ExecutionMode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ExecutionMode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnabledChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnabledChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnabledChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -141,6 +544,62 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.ExecutionMode != 0 {
+		n += 1 + sovParams(uint64(m.ExecutionMode))
+	}
+	if m.MaxGas != 0 {
+		n += 1 + sovParams(uint64(m.MaxGas))
+	}
+	if len(m.MinGasPrices) > 0 {
+		for _, e := range m.MinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.AllowedMsgTypes) > 0 {
+		for _, s := range m.AllowedMsgTypes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.DeniedMsgTypes) > 0 {
+		for _, s := range m.DeniedMsgTypes {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.EnabledChannels) > 0 {
+		for _, e := range m.EnabledChannels {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.LegacyMemo {
+		n += 2
+	}
+	if m.ReceiptRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.ReceiptRetentionBlocks))
+	}
+	if m.RetryQueueBlocks != 0 {
+		n += 1 + sovParams(uint64(m.RetryQueueBlocks))
+	}
+	return n
+}
+
+func (m *EnabledChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -179,6 +638,348 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionMode", wireType)
+			}
+			m.ExecutionMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionMode |= ExecutionMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPrices = append(m.MinGasPrices, types.DecCoin{})
+			if err := m.MinGasPrices[len(m.MinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedMsgTypes = append(m.AllowedMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeniedMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeniedMsgTypes = append(m.DeniedMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnabledChannels = append(m.EnabledChannels, EnabledChannel{})
+			if err := m.EnabledChannels[len(m.EnabledChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyMemo", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LegacyMemo = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptRetentionBlocks", wireType)
+			}
+			m.ReceiptRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiptRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryQueueBlocks", wireType)
+			}
+			m.RetryQueueBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryQueueBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnabledChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnabledChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnabledChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
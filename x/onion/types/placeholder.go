@@ -0,0 +1,131 @@
+package types
+
+import (
+	"fmt"
+	"reflect"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// ReceivedAmountDenom is the placeholder denom of the coins replaced with the
+// funds the packet delivered, see ExtensionOptionReceivedAmount.
+const ReceivedAmountDenom = "onion/received"
+
+var (
+	coinType  = reflect.TypeOf(sdk.Coin{})
+	coinsType = reflect.TypeOf(sdk.Coins{})
+	anyType   = reflect.TypeOf(codectypes.Any{})
+)
+
+// FillReceivedAmount replaces the coin in the ReceivedAmountDenom placeholder
+// denom within msgs with received and returns the number of coins replaced.
+// Messages nested in Any fields are searched and packed again. It fails when
+// received is less than the amount of the placeholder, or when msgs have more
+// than one placeholder as each would spend the whole of received.
+func FillReceivedAmount(msgs []sdk.Msg, received sdk.Coin) (int, error) {
+	count := 0
+	replace := func(coin *sdk.Coin) (bool, error) {
+		if coin.Denom != ReceivedAmountDenom {
+			return false, nil
+		}
+		if count > 0 {
+			return false, fmt.Errorf("more than one %s placeholder", ReceivedAmountDenom)
+		}
+		if coin.Amount.IsNil() || received.Amount.LT(coin.Amount) {
+			return false, fmt.Errorf("packet delivered %s, the tx requires at least %s", received, coin.Amount)
+		}
+		*coin = received
+		count++
+		return true, nil
+	}
+	for _, msg := range msgs {
+		if _, err := replaceCoins(reflect.ValueOf(msg), replace); err != nil {
+			return count, err
+		}
+	}
+	return count, nil
+}
+
+// replaceCoins calls replace on every coin reachable from v and reports
+// whether any was replaced.
+func replaceCoins(v reflect.Value, replace func(*sdk.Coin) (bool, error)) (bool, error) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return false, nil
+		}
+		if v.Type().Elem() == anyType {
+			return replaceAnyCoins(v.Interface().(*codectypes.Any), replace)
+		}
+		return replaceCoins(v.Elem(), replace)
+	case reflect.Interface:
+		if v.IsNil() {
+			return false, nil
+		}
+		return replaceCoins(v.Elem(), replace)
+	case reflect.Struct:
+		if v.Type() == coinType {
+			if !v.CanAddr() {
+				return false, nil
+			}
+			return replace(v.Addr().Interface().(*sdk.Coin))
+		}
+		if v.Type() == anyType {
+			if !v.CanAddr() {
+				return false, nil
+			}
+			return replaceAnyCoins(v.Addr().Interface().(*codectypes.Any), replace)
+		}
+		replaced := false
+		for i := 0; i < v.NumField(); i++ {
+			if !v.Type().Field(i).IsExported() {
+				continue
+			}
+			ok, err := replaceCoins(v.Field(i), replace)
+			if err != nil {
+				return false, err
+			}
+			replaced = replaced || ok
+		}
+		return replaced, nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return false, nil
+		}
+		replaced := false
+		for i := 0; i < v.Len(); i++ {
+			ok, err := replaceCoins(v.Index(i), replace)
+			if err != nil {
+				return false, err
+			}
+			replaced = replaced || ok
+		}
+		// the denom of a replaced coin may change the order
+		if replaced && v.Type() == coinsType {
+			v.Interface().(sdk.Coins).Sort()
+		}
+		return replaced, nil
+	}
+	return false, nil
+}
+
+// replaceAnyCoins replaces the coins of the message cached in msgAny and
+// packs it again so its value matches.
+func replaceAnyCoins(msgAny *codectypes.Any, replace func(*sdk.Coin) (bool, error)) (bool, error) {
+	msg, ok := msgAny.GetCachedValue().(proto.Message)
+	if !ok {
+		return false, nil
+	}
+	replaced, err := replaceCoins(reflect.ValueOf(msg), replace)
+	if err != nil || !replaced {
+		return replaced, err
+	}
+	packed, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return false, err
+	}
+	*msgAny = *packed
+	return true, nil
+}
@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"onion/x/onion/types"
+)
+
+func TestFillReceivedAmount(t *testing.T) {
+	from, to := sdk.AccAddress("from"), sdk.AccAddress("to")
+	received := sdk.NewInt64Coin("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", 100)
+	placeholder := sdk.NewInt64Coin(types.ReceivedAmountDenom, 90)
+
+	send := &banktypes.MsgSend{FromAddress: from.String(), ToAddress: to.String(), Amount: sdk.NewCoins(placeholder, sdk.NewInt64Coin("zzz", 1))}
+	exec := authz.NewMsgExec(from, []sdk.Msg{
+		&banktypes.MsgSend{FromAddress: from.String(), ToAddress: to.String(), Amount: sdk.NewCoins(placeholder)},
+	})
+
+	count, err := types.FillReceivedAmount([]sdk.Msg{send}, received)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.Equal(t, sdk.NewCoins(received, sdk.NewInt64Coin("zzz", 1)), send.Amount)
+
+	count, err = types.FillReceivedAmount([]sdk.Msg{&exec}, received)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	// the nested message is packed again
+	var nested banktypes.MsgSend
+	require.NoError(t, proto.Unmarshal(exec.Msgs[0].Value, &nested))
+	require.Equal(t, sdk.NewCoins(received), nested.Amount)
+	msgs, err := exec.GetMessages()
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(received), msgs[0].(*banktypes.MsgSend).Amount)
+
+	// the placeholder amount is the minimum
+	send = &banktypes.MsgSend{FromAddress: from.String(), ToAddress: to.String(), Amount: sdk.NewCoins(placeholder)}
+	_, err = types.FillReceivedAmount([]sdk.Msg{send}, sdk.NewInt64Coin(received.Denom, 89))
+	require.Error(t, err)
+
+	// each placeholder would spend all of received
+	first := &banktypes.MsgSend{FromAddress: from.String(), ToAddress: to.String(), Amount: sdk.NewCoins(placeholder)}
+	second := &banktypes.MsgSend{FromAddress: from.String(), ToAddress: to.String(), Amount: sdk.NewCoins(placeholder)}
+	_, err = types.FillReceivedAmount([]sdk.Msg{first, second}, received)
+	require.ErrorContains(t, err, "more than one")
+}
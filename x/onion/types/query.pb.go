@@ -6,6 +6,11 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types2 "github.com/cometbft/cometbft/abci/types"
+	types1 "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -118,22 +123,28 @@ func (m *QuerySequenceResponse) GetSeq() OnionSequence {
 	return OnionSequence{}
 }
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+// QuerySequencesRequest is request type for the Query/Sequences RPC method.
+type QuerySequencesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// min_sequence skips addresses whose onion sequence is lower.
+	MinSequence uint64 `protobuf:"varint,2,opt,name=min_sequence,json=minSequence,proto3" json:"min_sequence,omitempty"`
+	// active_since_height skips addresses that have not used an onion tx at or
+	// after this height.
+	ActiveSinceHeight int64 `protobuf:"varint,3,opt,name=active_since_height,json=activeSinceHeight,proto3" json:"active_since_height,omitempty"`
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QuerySequencesRequest) Reset()         { *m = QuerySequencesRequest{} }
+func (m *QuerySequencesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySequencesRequest) ProtoMessage()    {}
+func (*QuerySequencesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c57032993a556ca7, []int{2}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuerySequencesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySequencesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySequencesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -143,36 +154,57 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QuerySequencesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySequencesRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QuerySequencesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QuerySequencesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySequencesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySequencesRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params holds all the parameters of this module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *QuerySequencesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+func (m *QuerySequencesRequest) GetMinSequence() uint64 {
+	if m != nil {
+		return m.MinSequence
+	}
+	return 0
+}
+
+func (m *QuerySequencesRequest) GetActiveSinceHeight() int64 {
+	if m != nil {
+		return m.ActiveSinceHeight
+	}
+	return 0
+}
+
+// QuerySequencesResponse is response type for the Query/Sequences RPC method.
+type QuerySequencesResponse struct {
+	Sequences  []OnionSequence     `protobuf:"bytes,1,rep,name=sequences,proto3" json:"sequences"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySequencesResponse) Reset()         { *m = QuerySequencesResponse{} }
+func (m *QuerySequencesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySequencesResponse) ProtoMessage()    {}
+func (*QuerySequencesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_c57032993a556ca7, []int{3}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySequencesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySequencesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySequencesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -182,360 +214,3930 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QuerySequencesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySequencesResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QuerySequencesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QuerySequencesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySequencesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySequencesResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QuerySequencesResponse) GetSequences() []OnionSequence {
 	if m != nil {
-		return m.Params
+		return m.Sequences
 	}
-	return Params{}
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*QuerySequenceRequest)(nil), "onion.onion.QuerySequenceRequest")
-	proto.RegisterType((*QuerySequenceResponse)(nil), "onion.onion.QuerySequenceResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "onion.onion.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "onion.onion.QueryParamsResponse")
+func (m *QuerySequencesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("onion/onion/query.proto", fileDescriptor_c57032993a556ca7) }
-
-var fileDescriptor_c57032993a556ca7 = []byte{
-	// 368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xcb, 0x4a, 0xc3, 0x40,
-	0x14, 0xcd, 0xf8, 0xa8, 0x76, 0xba, 0x72, 0xd2, 0x62, 0x8d, 0x92, 0xb6, 0xd9, 0x28, 0x82, 0x19,
-	0xa9, 0xe0, 0x07, 0x74, 0x2b, 0xa2, 0xc6, 0x9d, 0xbb, 0xd1, 0x0e, 0x31, 0x60, 0x67, 0xd2, 0x4c,
-	0x0a, 0x56, 0x71, 0xe3, 0x17, 0x08, 0xfe, 0x84, 0x4b, 0x3f, 0xa3, 0xcb, 0x82, 0x1b, 0x57, 0x22,
-	0xad, 0xe0, 0xca, 0x7f, 0x90, 0x79, 0x04, 0x9a, 0x06, 0xdd, 0xdc, 0x4c, 0xee, 0x39, 0xe7, 0x9e,
-	0x73, 0x27, 0x81, 0xeb, 0x9c, 0x45, 0x9c, 0x61, 0x5d, 0xfb, 0x03, 0x9a, 0x0c, 0xfd, 0x38, 0xe1,
-	0x29, 0x47, 0x15, 0xd5, 0xf2, 0x55, 0x75, 0xd6, 0x48, 0x2f, 0x62, 0x1c, 0xab, 0xaa, 0x71, 0xa7,
-	0x1a, 0xf2, 0x90, 0xab, 0x23, 0x96, 0x27, 0xd3, 0xdd, 0x0a, 0x39, 0x0f, 0x6f, 0x28, 0x26, 0x71,
-	0x84, 0x09, 0x63, 0x3c, 0x25, 0x69, 0xc4, 0x99, 0x30, 0x68, 0x7d, 0xd6, 0x2c, 0x26, 0x09, 0xe9,
-	0x65, 0xc8, 0xc6, 0x2c, 0x12, 0x52, 0x46, 0x45, 0x64, 0x20, 0x6f, 0x1f, 0x56, 0xcf, 0x64, 0xae,
-	0x73, 0xda, 0x1f, 0x50, 0x76, 0x45, 0x03, 0xf9, 0x14, 0x29, 0xaa, 0xc3, 0x15, 0xd2, 0xed, 0x26,
-	0x54, 0x88, 0x3a, 0x68, 0x82, 0x9d, 0x72, 0x90, 0xbd, 0x7a, 0x47, 0xb0, 0x36, 0xa7, 0x10, 0x31,
-	0x67, 0x82, 0xa2, 0x36, 0x5c, 0x14, 0xb4, 0xaf, 0xe8, 0x95, 0xb6, 0xe3, 0xcf, 0x6c, 0xe8, 0x9f,
-	0xc8, 0x9a, 0x09, 0x3a, 0x4b, 0xa3, 0x8f, 0x86, 0x15, 0x48, 0xb2, 0x57, 0x85, 0x48, 0x0d, 0x3b,
-	0x55, 0x71, 0x8d, 0xb9, 0x77, 0x0c, 0xed, 0x5c, 0xd7, 0x18, 0x1c, 0xc2, 0x92, 0x5e, 0xcb, 0x78,
-	0xd8, 0x39, 0x0f, 0x4d, 0xee, 0x94, 0xe5, 0xf0, 0x97, 0xef, 0xd7, 0x5d, 0x10, 0x18, 0x76, 0xfb,
-	0x07, 0xc0, 0x65, 0x35, 0x0f, 0x5d, 0xc3, 0x92, 0xa6, 0xa1, 0x46, 0x4e, 0x5b, 0xcc, 0xe0, 0x34,
-	0xff, 0x26, 0xe8, 0x38, 0xde, 0xe6, 0xe3, 0xdb, 0xd7, 0xf3, 0x42, 0x0d, 0xd9, 0xb8, 0x78, 0xf1,
-	0xe8, 0x0e, 0xae, 0x66, 0xfb, 0xa2, 0x56, 0x71, 0xd4, 0xdc, 0x75, 0x3b, 0xde, 0x7f, 0x14, 0xe3,
-	0xb7, 0xad, 0xfc, 0x5a, 0xa8, 0x91, 0xf3, 0x13, 0x86, 0x86, 0xef, 0xcd, 0x07, 0x7a, 0xe8, 0xec,
-	0x8d, 0x26, 0x2e, 0x18, 0x4f, 0x5c, 0xf0, 0x39, 0x71, 0xc1, 0xd3, 0xd4, 0xb5, 0xc6, 0x53, 0xd7,
-	0x7a, 0x9f, 0xba, 0xd6, 0x85, 0xad, 0x35, 0xb7, 0x46, 0x9b, 0x0e, 0x63, 0x2a, 0x2e, 0x4b, 0xea,
-	0x4f, 0x38, 0xf8, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xa2, 0x2c, 0xac, 0x12, 0xad, 0x02, 0x00, 0x00,
+// QuerySimulateOnionRequest is request type for the Query/SimulateOnion RPC
+// method.
+type QuerySimulateOnionRequest struct {
+	// memo is the ICS-20 memo carrying the onion tx.
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// receiver is credited with funds before the onion tx runs, like the
+	// receiver of the transfer carrying the memo.
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// funds are the tokens the transfer delivers, in their denom on this chain.
+	// A single coin is exposed to the tx as the funds of the packet, see
+	// ExtensionOptionPacketBinding.
+	Funds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=funds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"funds"`
+	// channel_id is the channel of the transfer port the transfer is received
+	// on, required with funds. The ICS-20 module credits the funds like for a
+	// packet received on it: vouchers are minted and tokens returning to this
+	// chain are released from the channel escrow.
+	ChannelId string `protobuf:"bytes,4,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	Sequence(ctx context.Context, in *QuerySequenceRequest, opts ...grpc.CallOption) (*QuerySequenceResponse, error)
+func (m *QuerySimulateOnionRequest) Reset()         { *m = QuerySimulateOnionRequest{} }
+func (m *QuerySimulateOnionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateOnionRequest) ProtoMessage()    {}
+func (*QuerySimulateOnionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c57032993a556ca7, []int{4}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QuerySimulateOnionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateOnionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateOnionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateOnionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateOnionRequest.Merge(m, src)
+}
+func (m *QuerySimulateOnionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateOnionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateOnionRequest.DiscardUnknown(m)
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+var xxx_messageInfo_QuerySimulateOnionRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateOnionRequest) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/onion.onion.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateOnionRequest) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Sequence(ctx context.Context, in *QuerySequenceRequest, opts ...grpc.CallOption) (*QuerySequenceResponse, error) {
-	out := new(QuerySequenceResponse)
-	err := c.cc.Invoke(ctx, "/onion.onion.Query/Sequence", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateOnionRequest) GetFunds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Funds
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	Sequence(context.Context, *QuerySequenceRequest) (*QuerySequenceResponse, error)
+func (m *QuerySimulateOnionRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QuerySimulateOnionResponse is response type for the Query/SimulateOnion RPC
+// method.
+type QuerySimulateOnionResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// failed_stage, error and error_code describe the failure like the
+	// EventOnionExecution of a received packet would.
+	FailedStage ExecutionStage `protobuf:"varint,2,opt,name=failed_stage,json=failedStage,proto3,enum=onion.onion.ExecutionStage" json:"failed_stage,omitempty"`
+	Error       string         `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	ErrorCode   uint32         `protobuf:"varint,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// gas_limit is the effective gas limit of the onion tx.
+	GasLimit     uint64         `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	GasUsed      uint64         `protobuf:"varint,6,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	MsgResponses []*types1.Any  `protobuf:"bytes,7,rep,name=msg_responses,json=msgResponses,proto3" json:"msg_responses,omitempty"`
+	Events       []types2.Event `protobuf:"bytes,8,rep,name=events,proto3" json:"events"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QuerySimulateOnionResponse) Reset()         { *m = QuerySimulateOnionResponse{} }
+func (m *QuerySimulateOnionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateOnionResponse) ProtoMessage()    {}
+func (*QuerySimulateOnionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c57032993a556ca7, []int{5}
 }
-func (*UnimplementedQueryServer) Sequence(ctx context.Context, req *QuerySequenceRequest) (*QuerySequenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Sequence not implemented")
+func (m *QuerySimulateOnionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QuerySimulateOnionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateOnionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateOnionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateOnionResponse.Merge(m, src)
+}
+func (m *QuerySimulateOnionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateOnionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateOnionResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/onion.onion.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QuerySimulateOnionResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateOnionResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_Sequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySequenceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Sequence(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/onion.onion.Query/Sequence",
+func (m *QuerySimulateOnionResponse) GetFailedStage() ExecutionStage {
+	if m != nil {
+		return m.FailedStage
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Sequence(ctx, req.(*QuerySequenceRequest))
+	return EXECUTION_STAGE_UNSPECIFIED
+}
+
+func (m *QuerySimulateOnionResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "onion.onion.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Sequence",
-			Handler:    _Query_Sequence_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "onion/onion/query.proto",
+func (m *QuerySimulateOnionResponse) GetErrorCode() uint32 {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return 0
 }
 
-func (m *QuerySequenceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateOnionResponse) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QuerySequenceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySimulateOnionResponse) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
 }
 
-func (m *QuerySequenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QuerySimulateOnionResponse) GetMsgResponses() []*types1.Any {
+	if m != nil {
+		return m.MsgResponses
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QuerySequenceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySimulateOnionResponse) GetEvents() []types2.Event {
+	if m != nil {
+		return m.Events
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QuerySequenceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryEnabledChannelsRequest is request type for the Query/EnabledChannels
+// RPC method.
+type QueryEnabledChannelsRequest struct {
 }
 
-func (m *QuerySequenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Seq.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryEnabledChannelsRequest) Reset()         { *m = QueryEnabledChannelsRequest{} }
+func (m *QueryEnabledChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEnabledChannelsRequest) ProtoMessage()    {}
+func (*QueryEnabledChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c57032993a556ca7, []int{6}
+}
+func (m *QueryEnabledChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEnabledChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEnabledChannelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryEnabledChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEnabledChannelsRequest.Merge(m, src)
+}
+func (m *QueryEnabledChannelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEnabledChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEnabledChannelsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEnabledChannelsRequest proto.InternalMessageInfo
+
+// QueryEnabledChannelsResponse is response type for the Query/EnabledChannels
+// RPC method.
+type QueryEnabledChannelsResponse struct {
+	Channels []EnabledChannel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels"`
+}
+
+func (m *QueryEnabledChannelsResponse) Reset()         { *m = QueryEnabledChannelsResponse{} }
+func (m *QueryEnabledChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEnabledChannelsResponse) ProtoMessage()    {}
+func (*QueryEnabledChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c57032993a556ca7, []int{7}
+}
+func (m *QueryEnabledChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEnabledChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEnabledChannelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryEnabledChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEnabledChannelsResponse.Merge(m, src)
+}
+func (m *QueryEnabledChannelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEnabledChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEnabledChannelsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryEnabledChannelsResponse proto.InternalMessageInfo
+
+func (m *QueryEnabledChannelsResponse) GetChannels() []EnabledChannel {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryExecutionRequest is request type for the Query/Execution RPC method.
+type QueryExecutionRequest struct {
+	// port_id and channel_id identify this chain's end of the channel.
+	PortId         string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId      string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	PacketSequence uint64 `protobuf:"varint,3,opt,name=packet_sequence,json=packetSequence,proto3" json:"packet_sequence,omitempty"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryExecutionRequest) Reset()         { *m = QueryExecutionRequest{} }
+func (m *QueryExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutionRequest) ProtoMessage()    {}
+func (*QueryExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c57032993a556ca7, []int{8}
+}
+func (m *QueryExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutionRequest.Merge(m, src)
+}
+func (m *QueryExecutionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryExecutionRequest proto.InternalMessageInfo
+
+func (m *QueryExecutionRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryExecutionRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryExecutionRequest) GetPacketSequence() uint64 {
+	if m != nil {
+		return m.PacketSequence
+	}
+	return 0
+}
+
+// QueryExecutionResponse is response type for the Query/Execution RPC method.
+type QueryExecutionResponse struct {
+	Receipt ExecutionReceipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt"`
+}
+
+func (m *QueryExecutionResponse) Reset()         { *m = QueryExecutionResponse{} }
+func (m *QueryExecutionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutionResponse) ProtoMessage()    {}
+func (*QueryExecutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c57032993a556ca7, []int{9}
+}
+func (m *QueryExecutionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryExecutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutionResponse.Merge(m, src)
+}
+func (m *QueryExecutionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutionResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryExecutionResponse proto.InternalMessageInfo
+
+func (m *QueryExecutionResponse) GetReceipt() ExecutionReceipt {
+	if m != nil {
+		return m.Receipt
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ExecutionReceipt{}
 }
-func (m *QuerySequenceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+// QueryExecutionsBySignerRequest is request type for the
+// Query/ExecutionsBySigner RPC method.
+type QueryExecutionsBySignerRequest struct {
+	Signer     string             `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExecutionsBySignerRequest) Reset()         { *m = QueryExecutionsBySignerRequest{} }
+func (m *QueryExecutionsBySignerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutionsBySignerRequest) ProtoMessage()    {}
+func (*QueryExecutionsBySignerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c57032993a556ca7, []int{10}
+}
+func (m *QueryExecutionsBySignerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutionsBySignerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutionsBySignerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryExecutionsBySignerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutionsBySignerRequest.Merge(m, src)
+}
+func (m *QueryExecutionsBySignerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutionsBySignerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutionsBySignerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExecutionsBySignerRequest proto.InternalMessageInfo
+
+func (m *QueryExecutionsBySignerRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *QueryExecutionsBySignerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryExecutionsBySignerResponse is response type for the
+// Query/ExecutionsBySigner RPC method.
+type QueryExecutionsBySignerResponse struct {
+	Receipts   []ExecutionReceipt  `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExecutionsBySignerResponse) Reset()         { *m = QueryExecutionsBySignerResponse{} }
+func (m *QueryExecutionsBySignerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutionsBySignerResponse) ProtoMessage()    {}
+func (*QueryExecutionsBySignerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c57032993a556ca7, []int{11}
+}
+func (m *QueryExecutionsBySignerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutionsBySignerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutionsBySignerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExecutionsBySignerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutionsBySignerResponse.Merge(m, src)
+}
+func (m *QueryExecutionsBySignerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutionsBySignerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutionsBySignerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExecutionsBySignerResponse proto.InternalMessageInfo
+
+func (m *QueryExecutionsBySignerResponse) GetReceipts() []ExecutionReceipt {
+	if m != nil {
+		return m.Receipts
+	}
+	return nil
+}
+
+func (m *QueryExecutionsBySignerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryQueuedTxRequest is request type for the Query/QueuedTx RPC method.
+type QueryQueuedTxRequest struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryQueuedTxRequest) Reset()         { *m = QueryQueuedTxRequest{} }
+func (m *QueryQueuedTxRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedTxRequest) ProtoMessage()    {}
+func (*QueryQueuedTxRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c57032993a556ca7, []int{12}
+}
+func (m *QueryQueuedTxRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedTxRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedTxRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedTxRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedTxRequest.Merge(m, src)
+}
+func (m *QueryQueuedTxRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedTxRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedTxRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedTxRequest proto.InternalMessageInfo
+
+func (m *QueryQueuedTxRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryQueuedTxResponse is response type for the Query/QueuedTx RPC method.
+type QueryQueuedTxResponse struct {
+	QueuedTx QueuedOnionTx `protobuf:"bytes,1,opt,name=queued_tx,json=queuedTx,proto3" json:"queued_tx"`
+}
+
+func (m *QueryQueuedTxResponse) Reset()         { *m = QueryQueuedTxResponse{} }
+func (m *QueryQueuedTxResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedTxResponse) ProtoMessage()    {}
+func (*QueryQueuedTxResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c57032993a556ca7, []int{13}
+}
+func (m *QueryQueuedTxResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedTxResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedTxResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedTxResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedTxResponse.Merge(m, src)
+}
+func (m *QueryQueuedTxResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedTxResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedTxResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedTxResponse proto.InternalMessageInfo
+
+func (m *QueryQueuedTxResponse) GetQueuedTx() QueuedOnionTx {
+	if m != nil {
+		return m.QueuedTx
+	}
+	return QueuedOnionTx{}
+}
+
+// QueryQueuedTxsBySignerRequest is request type for the
+// Query/QueuedTxsBySigner RPC method.
+type QueryQueuedTxsBySignerRequest struct {
+	Signer     string             `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryQueuedTxsBySignerRequest) Reset()         { *m = QueryQueuedTxsBySignerRequest{} }
+func (m *QueryQueuedTxsBySignerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedTxsBySignerRequest) ProtoMessage()    {}
+func (*QueryQueuedTxsBySignerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c57032993a556ca7, []int{14}
+}
+func (m *QueryQueuedTxsBySignerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedTxsBySignerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedTxsBySignerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedTxsBySignerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedTxsBySignerRequest.Merge(m, src)
+}
+func (m *QueryQueuedTxsBySignerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedTxsBySignerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedTxsBySignerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedTxsBySignerRequest proto.InternalMessageInfo
+
+func (m *QueryQueuedTxsBySignerRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *QueryQueuedTxsBySignerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryQueuedTxsBySignerResponse is response type for the
+// Query/QueuedTxsBySigner RPC method.
+type QueryQueuedTxsBySignerResponse struct {
+	QueuedTxs  []QueuedOnionTx     `protobuf:"bytes,1,rep,name=queued_txs,json=queuedTxs,proto3" json:"queued_txs"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryQueuedTxsBySignerResponse) Reset()         { *m = QueryQueuedTxsBySignerResponse{} }
+func (m *QueryQueuedTxsBySignerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueuedTxsBySignerResponse) ProtoMessage()    {}
+func (*QueryQueuedTxsBySignerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c57032993a556ca7, []int{15}
+}
+func (m *QueryQueuedTxsBySignerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueuedTxsBySignerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueuedTxsBySignerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryQueuedTxsBySignerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueuedTxsBySignerResponse.Merge(m, src)
+}
+func (m *QueryQueuedTxsBySignerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueuedTxsBySignerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueuedTxsBySignerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryQueuedTxsBySignerResponse proto.InternalMessageInfo
+
+func (m *QueryQueuedTxsBySignerResponse) GetQueuedTxs() []QueuedOnionTx {
+	if m != nil {
+		return m.QueuedTxs
+	}
+	return nil
+}
+
+func (m *QueryQueuedTxsBySignerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c57032993a556ca7, []int{16}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c57032993a556ca7, []int{17}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func init() {
+	proto.RegisterType((*QuerySequenceRequest)(nil), "onion.onion.QuerySequenceRequest")
+	proto.RegisterType((*QuerySequenceResponse)(nil), "onion.onion.QuerySequenceResponse")
+	proto.RegisterType((*QuerySequencesRequest)(nil), "onion.onion.QuerySequencesRequest")
+	proto.RegisterType((*QuerySequencesResponse)(nil), "onion.onion.QuerySequencesResponse")
+	proto.RegisterType((*QuerySimulateOnionRequest)(nil), "onion.onion.QuerySimulateOnionRequest")
+	proto.RegisterType((*QuerySimulateOnionResponse)(nil), "onion.onion.QuerySimulateOnionResponse")
+	proto.RegisterType((*QueryEnabledChannelsRequest)(nil), "onion.onion.QueryEnabledChannelsRequest")
+	proto.RegisterType((*QueryEnabledChannelsResponse)(nil), "onion.onion.QueryEnabledChannelsResponse")
+	proto.RegisterType((*QueryExecutionRequest)(nil), "onion.onion.QueryExecutionRequest")
+	proto.RegisterType((*QueryExecutionResponse)(nil), "onion.onion.QueryExecutionResponse")
+	proto.RegisterType((*QueryExecutionsBySignerRequest)(nil), "onion.onion.QueryExecutionsBySignerRequest")
+	proto.RegisterType((*QueryExecutionsBySignerResponse)(nil), "onion.onion.QueryExecutionsBySignerResponse")
+	proto.RegisterType((*QueryQueuedTxRequest)(nil), "onion.onion.QueryQueuedTxRequest")
+	proto.RegisterType((*QueryQueuedTxResponse)(nil), "onion.onion.QueryQueuedTxResponse")
+	proto.RegisterType((*QueryQueuedTxsBySignerRequest)(nil), "onion.onion.QueryQueuedTxsBySignerRequest")
+	proto.RegisterType((*QueryQueuedTxsBySignerResponse)(nil), "onion.onion.QueryQueuedTxsBySignerResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "onion.onion.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "onion.onion.QueryParamsResponse")
+}
+
+func init() { proto.RegisterFile("onion/onion/query.proto", fileDescriptor_c57032993a556ca7) }
+
+var fileDescriptor_c57032993a556ca7 = []byte{
+	// 1346 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x5b, 0x6f, 0xdc, 0xc4,
+	0x17, 0x8f, 0x37, 0xc9, 0x66, 0x7d, 0xd2, 0x8b, 0x3a, 0xb9, 0xd4, 0x71, 0x9a, 0x4d, 0xea, 0xea,
+	0xdf, 0xe4, 0x9f, 0xb6, 0x76, 0x9b, 0x22, 0x24, 0x90, 0x28, 0xb0, 0x55, 0xa1, 0x15, 0x20, 0x5a,
+	0xa7, 0x80, 0xc4, 0x8b, 0x35, 0x6b, 0x4f, 0x9d, 0x51, 0x63, 0x7b, 0xe3, 0xf1, 0x46, 0x1b, 0xa2,
+	0x08, 0xd4, 0x77, 0x24, 0x24, 0xde, 0x91, 0x2a, 0x5e, 0x50, 0x79, 0x41, 0x7c, 0x8a, 0xbe, 0x20,
+	0x55, 0x82, 0x07, 0x5e, 0xb8, 0xa8, 0x41, 0xe2, 0x6b, 0x20, 0xcf, 0x65, 0xb3, 0x76, 0x9c, 0x6d,
+	0x84, 0x2a, 0x5e, 0xbc, 0x9e, 0x39, 0xbf, 0x73, 0xce, 0xef, 0x5c, 0x7c, 0x66, 0x16, 0xce, 0x26,
+	0x31, 0x4d, 0x62, 0x47, 0x3c, 0xb7, 0xba, 0x24, 0xdd, 0xb1, 0x3b, 0x69, 0x92, 0x25, 0x68, 0x92,
+	0x6f, 0xd9, 0xfc, 0x69, 0x9e, 0xc1, 0x11, 0x8d, 0x13, 0x87, 0x3f, 0x85, 0xdc, 0x9c, 0x0e, 0x93,
+	0x30, 0xe1, 0xaf, 0x4e, 0xfe, 0x26, 0x77, 0xcf, 0x85, 0x49, 0x12, 0x6e, 0x12, 0x07, 0x77, 0xa8,
+	0x83, 0xe3, 0x38, 0xc9, 0x70, 0x46, 0x93, 0x98, 0x49, 0xe9, 0xaa, 0x9f, 0xb0, 0x28, 0x61, 0x4e,
+	0x1b, 0x33, 0x22, 0x9c, 0x39, 0xdb, 0xd7, 0xda, 0x24, 0xc3, 0xd7, 0x9c, 0x0e, 0x0e, 0x69, 0xcc,
+	0xc1, 0x12, 0xdb, 0x1c, 0xc4, 0x2a, 0x94, 0x9f, 0x50, 0x25, 0x9f, 0x93, 0x9e, 0xf8, 0xaa, 0xdd,
+	0x7d, 0xe0, 0xe0, 0x58, 0x52, 0x37, 0xe7, 0x33, 0x12, 0x07, 0x24, 0x8d, 0x68, 0x9c, 0x39, 0xb8,
+	0xed, 0x53, 0x27, 0xdb, 0xe9, 0x10, 0xc5, 0xc1, 0x18, 0x0c, 0x98, 0x6c, 0x93, 0x38, 0xab, 0x94,
+	0x74, 0x70, 0x8a, 0x23, 0x25, 0x99, 0x1b, 0x94, 0x84, 0x24, 0x26, 0x8c, 0x56, 0x8a, 0x52, 0xe2,
+	0x13, 0xda, 0xc9, 0xa4, 0xe8, 0x6c, 0x51, 0x94, 0xa9, 0xd4, 0x5a, 0x57, 0x61, 0xfa, 0x5e, 0x1e,
+	0xfc, 0x3a, 0xd9, 0xea, 0x92, 0xd8, 0x27, 0x6e, 0xfe, 0xcb, 0x32, 0x64, 0xc0, 0x04, 0x0e, 0x82,
+	0x94, 0x30, 0x66, 0x68, 0x4b, 0xda, 0x8a, 0xee, 0xaa, 0xa5, 0xf5, 0x1e, 0xcc, 0x94, 0x34, 0x58,
+	0x27, 0x89, 0x19, 0x41, 0x6b, 0x30, 0xca, 0xc8, 0x16, 0x87, 0x4f, 0xae, 0x99, 0xf6, 0x40, 0xcd,
+	0xec, 0x0f, 0xf3, 0xa7, 0x52, 0x68, 0x8d, 0x3d, 0xfd, 0x7d, 0x71, 0xc4, 0xcd, 0xc1, 0xd6, 0x8f,
+	0x5a, 0xc9, 0x1a, 0x53, 0x04, 0xde, 0x01, 0x38, 0xa8, 0x83, 0x34, 0x7a, 0xd1, 0x16, 0x85, 0xb0,
+	0xf3, 0x42, 0xd8, 0xa2, 0x43, 0x64, 0x39, 0xec, 0xbb, 0x38, 0x54, 0xe4, 0xdd, 0x01, 0x4d, 0x74,
+	0x1e, 0x4e, 0x44, 0x34, 0xf6, 0x98, 0xb4, 0x6f, 0xd4, 0x96, 0xb4, 0x95, 0x31, 0x77, 0x32, 0xa2,
+	0x7d, 0x3e, 0xc8, 0x86, 0x29, 0xec, 0x67, 0x74, 0x9b, 0x78, 0x8c, 0xc6, 0x3e, 0xf1, 0x36, 0x08,
+	0x0d, 0x37, 0x32, 0x63, 0x74, 0x49, 0x5b, 0x19, 0x75, 0xcf, 0x08, 0xd1, 0x7a, 0x2e, 0xb9, 0xcd,
+	0x05, 0xd6, 0x63, 0x0d, 0x66, 0xcb, 0xa4, 0x65, 0x0e, 0x6e, 0x80, 0xae, 0x3c, 0xe5, 0x89, 0x1b,
+	0x3d, 0x56, 0x26, 0x0e, 0x54, 0xd0, 0xbb, 0x85, 0xa8, 0x6b, 0x3c, 0xea, 0xe5, 0x17, 0x46, 0x2d,
+	0x9c, 0x0f, 0x86, 0x6d, 0xfd, 0xa4, 0xc1, 0x9c, 0xe0, 0x48, 0xa3, 0xee, 0x26, 0xce, 0x08, 0x77,
+	0xac, 0x92, 0x8b, 0x60, 0x2c, 0x22, 0x51, 0x22, 0x4b, 0xcb, 0xdf, 0x91, 0x09, 0x0d, 0xde, 0x33,
+	0xdb, 0x24, 0xe5, 0x8e, 0x75, 0xb7, 0xbf, 0x46, 0x18, 0xc6, 0x1f, 0x74, 0xe3, 0x80, 0x19, 0xa3,
+	0x3c, 0xa4, 0xb9, 0x02, 0x23, 0xc5, 0xe5, 0x66, 0x42, 0xe3, 0xd6, 0xd5, 0x3c, 0xa2, 0x27, 0x7f,
+	0x2c, 0xae, 0x84, 0x34, 0xdb, 0xe8, 0xb6, 0x6d, 0x3f, 0x89, 0x1c, 0xf9, 0xf5, 0x88, 0x9f, 0x2b,
+	0x2c, 0x78, 0x28, 0x3f, 0x82, 0x5c, 0x81, 0xb9, 0xc2, 0x32, 0x5a, 0x00, 0xf0, 0x37, 0x70, 0x1c,
+	0x93, 0x4d, 0x8f, 0x06, 0xc6, 0x18, 0x27, 0xa0, 0xcb, 0x9d, 0x3b, 0x81, 0xf5, 0x4b, 0x0d, 0xcc,
+	0xaa, 0x78, 0x64, 0xde, 0x0d, 0x98, 0x60, 0x5d, 0xdf, 0x57, 0xed, 0xda, 0x70, 0xd5, 0x12, 0xdd,
+	0x80, 0x13, 0x0f, 0x30, 0xdd, 0x24, 0x81, 0xc7, 0x32, 0x1c, 0x8a, 0xfa, 0x9f, 0x5a, 0x9b, 0x2f,
+	0x14, 0xe5, 0x56, 0x8f, 0xf8, 0xdd, 0x3c, 0x6d, 0xeb, 0x39, 0xc4, 0x9d, 0x14, 0x0a, 0x7c, 0x81,
+	0xa6, 0x61, 0x9c, 0xa4, 0x69, 0x92, 0xf2, 0x76, 0xd0, 0x5d, 0xb1, 0xc8, 0xd9, 0xf2, 0x17, 0xcf,
+	0x4f, 0x02, 0xc2, 0xd9, 0x9e, 0x74, 0x75, 0xbe, 0x73, 0x33, 0x09, 0x08, 0x9a, 0x07, 0x3d, 0xc4,
+	0xcc, 0xdb, 0xa4, 0x11, 0xcd, 0x8c, 0x71, 0xde, 0x71, 0x8d, 0x10, 0xb3, 0xf7, 0xf3, 0x35, 0x9a,
+	0x83, 0xfc, 0xdd, 0xeb, 0x32, 0x12, 0x18, 0x75, 0x2e, 0x9b, 0x08, 0x31, 0xfb, 0x88, 0x91, 0x00,
+	0xbd, 0x06, 0x27, 0x23, 0x16, 0x7a, 0xa9, 0x0c, 0x8b, 0x19, 0x13, 0x3c, 0xdf, 0xd3, 0xb6, 0x18,
+	0x30, 0xb6, 0x1a, 0x30, 0xf6, 0xdb, 0xf1, 0x8e, 0x7b, 0x22, 0x62, 0xa1, 0x4a, 0x00, 0x43, 0xaf,
+	0x40, 0x5d, 0x4c, 0x10, 0xa3, 0xc1, 0x75, 0x66, 0xed, 0x83, 0xc9, 0x63, 0xe7, 0x93, 0xc7, 0xbe,
+	0x95, 0x8b, 0x65, 0xcb, 0x49, 0xac, 0xb5, 0x00, 0xf3, 0x3c, 0xab, 0xb7, 0x62, 0xdc, 0xde, 0x24,
+	0xc1, 0x4d, 0x91, 0x6f, 0xf5, 0x11, 0x5a, 0x6d, 0x38, 0x57, 0x2d, 0x96, 0x69, 0x6f, 0x41, 0x43,
+	0x96, 0x48, 0x75, 0x7b, 0x29, 0xb1, 0x05, 0xbd, 0x96, 0x9e, 0xfb, 0xfe, 0xee, 0xef, 0x1f, 0x56,
+	0x35, 0xb7, 0xaf, 0x67, 0xf5, 0xe4, 0x04, 0xe8, 0x17, 0x41, 0x35, 0xe9, 0x59, 0x98, 0xe8, 0x24,
+	0x69, 0x96, 0xb7, 0x83, 0xe8, 0xd3, 0x7a, 0xbe, 0xbc, 0x13, 0x94, 0x5a, 0xa5, 0x56, 0x6a, 0x15,
+	0xb4, 0x0c, 0xa7, 0x3b, 0xd8, 0x7f, 0x48, 0xb2, 0x83, 0x8f, 0x7e, 0x94, 0xa7, 0xf9, 0x94, 0xd8,
+	0x56, 0x5f, 0x9f, 0xf5, 0x09, 0xcc, 0x96, 0x3d, 0xcb, 0xb8, 0xde, 0x80, 0x09, 0x39, 0x3f, 0xe5,
+	0xe4, 0x59, 0xa8, 0xee, 0x17, 0x57, 0x80, 0x64, 0x52, 0x95, 0x8e, 0xf5, 0x85, 0x06, 0xcd, 0xa2,
+	0x65, 0xd6, 0xda, 0x59, 0xa7, 0x61, 0x4c, 0x52, 0x15, 0xdc, 0x2c, 0xd4, 0x19, 0xdf, 0x50, 0xb1,
+	0x89, 0x55, 0x69, 0xec, 0xd5, 0xfe, 0xed, 0xd8, 0xb3, 0xbe, 0xd7, 0x60, 0xf1, 0x48, 0x0a, 0x32,
+	0xca, 0x37, 0xe5, 0x17, 0xdf, 0xc9, 0x54, 0xf5, 0x8e, 0x15, 0x66, 0x5f, 0xe9, 0xe5, 0x4d, 0xab,
+	0x8b, 0xf2, 0x14, 0xba, 0xd7, 0x25, 0x5d, 0x12, 0xdc, 0xef, 0xa9, 0x2c, 0x9d, 0x82, 0x9a, 0xac,
+	0xfe, 0x98, 0x5b, 0xa3, 0x81, 0xf5, 0x31, 0xcc, 0x94, 0x70, 0xfd, 0x82, 0xe9, 0x5b, 0x7c, 0xcf,
+	0xcb, 0x7a, 0x95, 0x27, 0x90, 0xd0, 0xe0, 0x43, 0xe3, 0x7e, 0x4f, 0x05, 0xb2, 0x25, 0xcd, 0x58,
+	0x9f, 0xc3, 0x42, 0xc1, 0xee, 0x7f, 0x5e, 0xae, 0x27, 0xaa, 0x63, 0x2a, 0x18, 0xf4, 0xab, 0x05,
+	0xfd, 0x10, 0xab, 0xcf, 0x96, 0xaa, 0x18, 0x75, 0x15, 0xe3, 0x4b, 0xac, 0xd6, 0x34, 0x20, 0xce,
+	0xf5, 0x2e, 0xbf, 0x97, 0xa8, 0x59, 0xf1, 0x01, 0x4c, 0x15, 0x76, 0x25, 0xed, 0x57, 0xa1, 0x2e,
+	0xee, 0x2f, 0xb2, 0x2c, 0x53, 0x05, 0xca, 0x02, 0x3c, 0x38, 0x18, 0x24, 0x7a, 0xed, 0x37, 0x1d,
+	0xc6, 0xb9, 0x3d, 0xb4, 0x01, 0x75, 0x01, 0x43, 0x8b, 0xe5, 0x70, 0x4b, 0x1c, 0xcc, 0xa5, 0xa3,
+	0x01, 0x82, 0x8e, 0x35, 0xff, 0xe8, 0xe7, 0xbf, 0xbe, 0xae, 0xcd, 0xa0, 0x29, 0xe7, 0xf0, 0x0d,
+	0x0b, 0x7d, 0x06, 0x8d, 0xfe, 0xa5, 0xe0, 0xfc, 0x61, 0x53, 0xa5, 0x3b, 0x92, 0x69, 0x0d, 0x83,
+	0x48, 0x7f, 0xcb, 0xdc, 0xdf, 0x79, 0xb4, 0x58, 0xf0, 0xa7, 0x06, 0x93, 0xb3, 0x2b, 0x6f, 0x55,
+	0x7b, 0x28, 0x03, 0x7d, 0xbd, 0x7f, 0x0d, 0x18, 0x62, 0xb9, 0x1f, 0xeb, 0x85, 0xa1, 0x18, 0xe9,
+	0xbe, 0xc9, 0xdd, 0x1b, 0x68, 0xb6, 0xd2, 0x3d, 0x43, 0x8f, 0x34, 0x38, 0x59, 0x38, 0x51, 0xd1,
+	0xc5, 0x0a, 0xb3, 0x15, 0x57, 0x08, 0x73, 0xf9, 0x85, 0x38, 0x49, 0x61, 0x89, 0x53, 0x30, 0x5f,
+	0xd7, 0x56, 0xad, 0x99, 0x22, 0x0b, 0x09, 0x47, 0x5f, 0x6a, 0x70, 0xba, 0x74, 0xc2, 0xa0, 0x95,
+	0xc3, 0xe6, 0xab, 0xcf, 0x28, 0xf3, 0xff, 0xc7, 0x40, 0x4a, 0x2a, 0xff, 0xe3, 0x54, 0x16, 0xd1,
+	0x42, 0x81, 0x07, 0x11, 0x68, 0x4f, 0x9d, 0x48, 0xe8, 0x1b, 0x0d, 0xf4, 0xfe, 0xec, 0xab, 0xaa,
+	0x45, 0xf9, 0xa8, 0x32, 0x2f, 0x0c, 0xc5, 0x48, 0xef, 0xb7, 0xb9, 0xf7, 0x16, 0x7a, 0xab, 0xe8,
+	0x5d, 0xe1, 0x98, 0xb3, 0x2b, 0x8f, 0xbb, 0x3d, 0x67, 0xf7, 0xe0, 0x7c, 0xdb, 0x73, 0x76, 0x4b,
+	0xa7, 0xd9, 0x1e, 0xfa, 0x56, 0x03, 0x74, 0x78, 0xae, 0xa3, 0x4b, 0x43, 0x58, 0x94, 0x27, 0x9a,
+	0x79, 0xf9, 0x78, 0x60, 0xc9, 0x7d, 0x8d, 0x73, 0xbf, 0x8c, 0x56, 0x8f, 0xe0, 0xee, 0xb5, 0x77,
+	0x3c, 0x31, 0x12, 0x9d, 0x5d, 0xf1, 0xbb, 0x87, 0x7a, 0xd0, 0x50, 0xd3, 0xac, 0xea, 0x6b, 0x2a,
+	0xcd, 0x7a, 0xd3, 0x1a, 0x06, 0x19, 0x5a, 0x40, 0xfe, 0x7f, 0xc6, 0xe3, 0x83, 0xce, 0xd9, 0xa5,
+	0xc1, 0x1e, 0x7a, 0xac, 0xc1, 0x99, 0x43, 0x83, 0x14, 0xad, 0x1e, 0xed, 0xe0, 0x50, 0x76, 0x2e,
+	0x1d, 0x0b, 0x2b, 0x59, 0x5d, 0xe7, 0xac, 0xae, 0xa0, 0x4b, 0x47, 0xb1, 0xaa, 0xc8, 0x4e, 0xeb,
+	0xca, 0xd3, 0xe7, 0x4d, 0xed, 0xd9, 0xf3, 0xa6, 0xf6, 0xe7, 0xf3, 0xa6, 0xf6, 0xd5, 0x7e, 0x73,
+	0xe4, 0xd9, 0x7e, 0x73, 0xe4, 0xd7, 0xfd, 0xe6, 0xc8, 0xa7, 0x53, 0x42, 0xbf, 0x27, 0xed, 0xf0,
+	0xbb, 0x72, 0xbb, 0xce, 0xaf, 0x7e, 0xd7, 0xff, 0x19, 0x00, 0xce, 0xc8, 0x6e, 0x98, 0x24, 0x0f,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	Sequence(ctx context.Context, in *QuerySequenceRequest, opts ...grpc.CallOption) (*QuerySequenceResponse, error)
+	// Sequences lists the onion sequences of all addresses that have used
+	// onion txs.
+	Sequences(ctx context.Context, in *QuerySequencesRequest, opts ...grpc.CallOption) (*QuerySequencesResponse, error)
+	// SimulateOnion runs the onion tx of a memo on a discarded branch of the
+	// current state, as if it had arrived with an ICS-20 transfer.
+	SimulateOnion(ctx context.Context, in *QuerySimulateOnionRequest, opts ...grpc.CallOption) (*QuerySimulateOnionResponse, error)
+	// EnabledChannels queries the channels on which onion txs are executed.
+	EnabledChannels(ctx context.Context, in *QueryEnabledChannelsRequest, opts ...grpc.CallOption) (*QueryEnabledChannelsResponse, error)
+	// Execution queries the receipt of the onion tx carried by a received
+	// packet.
+	Execution(ctx context.Context, in *QueryExecutionRequest, opts ...grpc.CallOption) (*QueryExecutionResponse, error)
+	// ExecutionsBySigner lists the receipts of onion txs signed by an address.
+	ExecutionsBySigner(ctx context.Context, in *QueryExecutionsBySignerRequest, opts ...grpc.CallOption) (*QueryExecutionsBySignerResponse, error)
+	// QueuedTx queries an onion tx of the retry queue.
+	QueuedTx(ctx context.Context, in *QueryQueuedTxRequest, opts ...grpc.CallOption) (*QueryQueuedTxResponse, error)
+	// QueuedTxsBySigner lists the onion txs of the retry queue signed by an
+	// address.
+	QueuedTxsBySigner(ctx context.Context, in *QueryQueuedTxsBySignerRequest, opts ...grpc.CallOption) (*QueryQueuedTxsBySignerResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/onion.onion.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Sequence(ctx context.Context, in *QuerySequenceRequest, opts ...grpc.CallOption) (*QuerySequenceResponse, error) {
+	out := new(QuerySequenceResponse)
+	err := c.cc.Invoke(ctx, "/onion.onion.Query/Sequence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Sequences(ctx context.Context, in *QuerySequencesRequest, opts ...grpc.CallOption) (*QuerySequencesResponse, error) {
+	out := new(QuerySequencesResponse)
+	err := c.cc.Invoke(ctx, "/onion.onion.Query/Sequences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateOnion(ctx context.Context, in *QuerySimulateOnionRequest, opts ...grpc.CallOption) (*QuerySimulateOnionResponse, error) {
+	out := new(QuerySimulateOnionResponse)
+	err := c.cc.Invoke(ctx, "/onion.onion.Query/SimulateOnion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EnabledChannels(ctx context.Context, in *QueryEnabledChannelsRequest, opts ...grpc.CallOption) (*QueryEnabledChannelsResponse, error) {
+	out := new(QueryEnabledChannelsResponse)
+	err := c.cc.Invoke(ctx, "/onion.onion.Query/EnabledChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Execution(ctx context.Context, in *QueryExecutionRequest, opts ...grpc.CallOption) (*QueryExecutionResponse, error) {
+	out := new(QueryExecutionResponse)
+	err := c.cc.Invoke(ctx, "/onion.onion.Query/Execution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExecutionsBySigner(ctx context.Context, in *QueryExecutionsBySignerRequest, opts ...grpc.CallOption) (*QueryExecutionsBySignerResponse, error) {
+	out := new(QueryExecutionsBySignerResponse)
+	err := c.cc.Invoke(ctx, "/onion.onion.Query/ExecutionsBySigner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedTx(ctx context.Context, in *QueryQueuedTxRequest, opts ...grpc.CallOption) (*QueryQueuedTxResponse, error) {
+	out := new(QueryQueuedTxResponse)
+	err := c.cc.Invoke(ctx, "/onion.onion.Query/QueuedTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueuedTxsBySigner(ctx context.Context, in *QueryQueuedTxsBySignerRequest, opts ...grpc.CallOption) (*QueryQueuedTxsBySignerResponse, error) {
+	out := new(QueryQueuedTxsBySignerResponse)
+	err := c.cc.Invoke(ctx, "/onion.onion.Query/QueuedTxsBySigner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	Sequence(context.Context, *QuerySequenceRequest) (*QuerySequenceResponse, error)
+	// Sequences lists the onion sequences of all addresses that have used
+	// onion txs.
+	Sequences(context.Context, *QuerySequencesRequest) (*QuerySequencesResponse, error)
+	// SimulateOnion runs the onion tx of a memo on a discarded branch of the
+	// current state, as if it had arrived with an ICS-20 transfer.
+	SimulateOnion(context.Context, *QuerySimulateOnionRequest) (*QuerySimulateOnionResponse, error)
+	// EnabledChannels queries the channels on which onion txs are executed.
+	EnabledChannels(context.Context, *QueryEnabledChannelsRequest) (*QueryEnabledChannelsResponse, error)
+	// Execution queries the receipt of the onion tx carried by a received
+	// packet.
+	Execution(context.Context, *QueryExecutionRequest) (*QueryExecutionResponse, error)
+	// ExecutionsBySigner lists the receipts of onion txs signed by an address.
+	ExecutionsBySigner(context.Context, *QueryExecutionsBySignerRequest) (*QueryExecutionsBySignerResponse, error)
+	// QueuedTx queries an onion tx of the retry queue.
+	QueuedTx(context.Context, *QueryQueuedTxRequest) (*QueryQueuedTxResponse, error)
+	// QueuedTxsBySigner lists the onion txs of the retry queue signed by an
+	// address.
+	QueuedTxsBySigner(context.Context, *QueryQueuedTxsBySignerRequest) (*QueryQueuedTxsBySignerResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Sequence(ctx context.Context, req *QuerySequenceRequest) (*QuerySequenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sequence not implemented")
+}
+func (*UnimplementedQueryServer) Sequences(ctx context.Context, req *QuerySequencesRequest) (*QuerySequencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sequences not implemented")
+}
+func (*UnimplementedQueryServer) SimulateOnion(ctx context.Context, req *QuerySimulateOnionRequest) (*QuerySimulateOnionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateOnion not implemented")
+}
+func (*UnimplementedQueryServer) EnabledChannels(ctx context.Context, req *QueryEnabledChannelsRequest) (*QueryEnabledChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnabledChannels not implemented")
+}
+func (*UnimplementedQueryServer) Execution(ctx context.Context, req *QueryExecutionRequest) (*QueryExecutionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Execution not implemented")
+}
+func (*UnimplementedQueryServer) ExecutionsBySigner(ctx context.Context, req *QueryExecutionsBySignerRequest) (*QueryExecutionsBySignerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutionsBySigner not implemented")
+}
+func (*UnimplementedQueryServer) QueuedTx(ctx context.Context, req *QueryQueuedTxRequest) (*QueryQueuedTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedTx not implemented")
+}
+func (*UnimplementedQueryServer) QueuedTxsBySigner(ctx context.Context, req *QueryQueuedTxsBySignerRequest) (*QueryQueuedTxsBySignerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueuedTxsBySigner not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onion.onion.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Sequence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySequenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sequence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onion.onion.Query/Sequence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sequence(ctx, req.(*QuerySequenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Sequences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySequencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sequences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onion.onion.Query/Sequences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sequences(ctx, req.(*QuerySequencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateOnion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateOnionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateOnion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onion.onion.Query/SimulateOnion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateOnion(ctx, req.(*QuerySimulateOnionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EnabledChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEnabledChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EnabledChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onion.onion.Query/EnabledChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EnabledChannels(ctx, req.(*QueryEnabledChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Execution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Execution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onion.onion.Query/Execution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Execution(ctx, req.(*QueryExecutionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecutionsBySigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutionsBySignerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutionsBySigner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onion.onion.Query/ExecutionsBySigner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutionsBySigner(ctx, req.(*QueryExecutionsBySignerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onion.onion.Query/QueuedTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedTx(ctx, req.(*QueryQueuedTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueuedTxsBySigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueuedTxsBySignerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueuedTxsBySigner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onion.onion.Query/QueuedTxsBySigner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueuedTxsBySigner(ctx, req.(*QueryQueuedTxsBySignerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "onion.onion.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Sequence",
+			Handler:    _Query_Sequence_Handler,
+		},
+		{
+			MethodName: "Sequences",
+			Handler:    _Query_Sequences_Handler,
+		},
+		{
+			MethodName: "SimulateOnion",
+			Handler:    _Query_SimulateOnion_Handler,
+		},
+		{
+			MethodName: "EnabledChannels",
+			Handler:    _Query_EnabledChannels_Handler,
+		},
+		{
+			MethodName: "Execution",
+			Handler:    _Query_Execution_Handler,
+		},
+		{
+			MethodName: "ExecutionsBySigner",
+			Handler:    _Query_ExecutionsBySigner_Handler,
+		},
+		{
+			MethodName: "QueuedTx",
+			Handler:    _Query_QueuedTx_Handler,
+		},
+		{
+			MethodName: "QueuedTxsBySigner",
+			Handler:    _Query_QueuedTxsBySigner_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "onion/onion/query.proto",
+}
+
+func (m *QuerySequenceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySequenceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySequenceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySequenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySequenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySequenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Seq.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySequencesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySequencesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySequencesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActiveSinceHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ActiveSinceHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinSequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySequencesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySequencesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySequencesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sequences) > 0 {
+		for iNdEx := len(m.Sequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateOnionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateOnionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateOnionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Funds) > 0 {
+		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateOnionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateOnionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateOnionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MsgResponses) > 0 {
+		for iNdEx := len(m.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ErrorCode != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ErrorCode))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FailedStage != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FailedStage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEnabledChannelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEnabledChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEnabledChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEnabledChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEnabledChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEnabledChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PacketSequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PacketSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Receipt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutionsBySignerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutionsBySignerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutionsBySignerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutionsBySignerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutionsBySignerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutionsBySignerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Receipts) > 0 {
+		for iNdEx := len(m.Receipts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receipts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.QueuedTx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedTxsBySignerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedTxsBySignerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedTxsBySignerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueuedTxsBySignerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueuedTxsBySignerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueuedTxsBySignerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.QueuedTxs) > 0 {
+		for iNdEx := len(m.QueuedTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QuerySequenceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySequenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Seq.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySequencesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MinSequence != 0 {
+		n += 1 + sovQuery(uint64(m.MinSequence))
+	}
+	if m.ActiveSinceHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ActiveSinceHeight))
+	}
+	return n
+}
+
+func (m *QuerySequencesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sequences) > 0 {
+		for _, e := range m.Sequences {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateOnionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateOnionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.FailedStage != 0 {
+		n += 1 + sovQuery(uint64(m.FailedStage))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ErrorCode != 0 {
+		n += 1 + sovQuery(uint64(m.ErrorCode))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovQuery(uint64(m.GasUsed))
+	}
+	if len(m.MsgResponses) > 0 {
+		for _, e := range m.MsgResponses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEnabledChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEnabledChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryExecutionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PacketSequence != 0 {
+		n += 1 + sovQuery(uint64(m.PacketSequence))
+	}
+	return n
+}
+
+func (m *QueryExecutionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Receipt.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExecutionsBySignerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExecutionsBySignerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Receipts) > 0 {
+		for _, e := range m.Receipts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueuedTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryQueuedTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.QueuedTx.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryQueuedTxsBySignerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryQueuedTxsBySignerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.QueuedTxs) > 0 {
+		for _, e := range m.QueuedTxs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QuerySequenceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySequenceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySequenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySequenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySequenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySequenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Seq.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySequencesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySequencesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySequencesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSequence", wireType)
+			}
+			m.MinSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveSinceHeight", wireType)
+			}
+			m.ActiveSinceHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActiveSinceHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySequencesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySequencesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySequencesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequences = append(m.Sequences, OnionSequence{})
+			if err := m.Sequences[len(m.Sequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateOnionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateOnionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateOnionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funds = append(m.Funds, types.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateOnionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateOnionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateOnionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedStage", wireType)
+			}
+			m.FailedStage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedStage |= ExecutionStage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
+			}
+			m.ErrorCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrorCode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResponses = append(m.MsgResponses, &types1.Any{})
+			if err := m.MsgResponses[len(m.MsgResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, types2.Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEnabledChannelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEnabledChannelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEnabledChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEnabledChannelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEnabledChannelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEnabledChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, EnabledChannel{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExecutionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSequence", wireType)
+			}
+			m.PacketSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExecutionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Receipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExecutionsBySignerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutionsBySignerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutionsBySignerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExecutionsBySignerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutionsBySignerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutionsBySignerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receipts = append(m.Receipts, ExecutionReceipt{})
+			if err := m.Receipts[len(m.Receipts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QuerySequenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Seq.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
-
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryQueuedTxRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedTxRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
+func (m *QueryQueuedTxResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueuedTxResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueuedTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedTx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QueuedTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QuerySequenceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryQueuedTxsBySignerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -558,15 +4160,15 @@ func (m *QuerySequenceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySequenceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQueuedTxsBySignerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySequenceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQueuedTxsBySignerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -594,7 +4196,43 @@ func (m *QuerySequenceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -617,7 +4255,7 @@ func (m *QuerySequenceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySequenceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryQueuedTxsBySignerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -640,15 +4278,15 @@ func (m *QuerySequenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySequenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryQueuedTxsBySignerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySequenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryQueuedTxsBySignerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedTxs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -675,7 +4313,44 @@ func (m *QuerySequenceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Seq.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.QueuedTxs = append(m.QueuedTxs, QueuedOnionTx{})
+			if err := m.QueuedTxs[len(m.QueuedTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,830 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: onion/onion/receipt.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ExecutionReceipt records the outcome of the onion tx carried by a received
+// ICS-20 packet. Receipts are kept for Params.receipt_retention_blocks
+// blocks. In atomic mode a failed execution reverts the receipt along with
+// the transfer, the error acknowledgement is the only record of it then.
+type ExecutionReceipt struct {
+	// port_id, channel_id and packet_sequence identify the packet on this
+	// chain's end of the channel.
+	PortId         string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId      string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	PacketSequence uint64 `protobuf:"varint,3,opt,name=packet_sequence,json=packetSequence,proto3" json:"packet_sequence,omitempty"`
+	// height is the block height the packet was received at.
+	Height  int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	Success bool  `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	// failed_stage is unspecified when the execution succeeded.
+	FailedStage ExecutionStage `protobuf:"varint,6,opt,name=failed_stage,json=failedStage,proto3,enum=onion.onion.ExecutionStage" json:"failed_stage,omitempty"`
+	// error_code is the ABCI code of the error within the onion codespace.
+	ErrorCode uint32 `protobuf:"varint,7,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	Error     string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	// signers of the onion tx, empty if the memo could not be decoded.
+	Signers []string `protobuf:"bytes,9,rep,name=signers,proto3" json:"signers,omitempty"`
+	// tx_hash is the hex encoded hash of the onion tx bytes.
+	TxHash  string `protobuf:"bytes,10,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	GasUsed uint64 `protobuf:"varint,11,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// msg_responses holds the responses of the tx messages when the execution
+	// succeeded.
+	MsgResponses []*types.Any `protobuf:"bytes,12,rep,name=msg_responses,json=msgResponses,proto3" json:"msg_responses,omitempty"`
+}
+
+func (m *ExecutionReceipt) Reset()         { *m = ExecutionReceipt{} }
+func (m *ExecutionReceipt) String() string { return proto.CompactTextString(m) }
+func (*ExecutionReceipt) ProtoMessage()    {}
+func (*ExecutionReceipt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_805d5652b652698e, []int{0}
+}
+func (m *ExecutionReceipt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionReceipt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionReceipt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionReceipt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionReceipt.Merge(m, src)
+}
+func (m *ExecutionReceipt) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionReceipt) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionReceipt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionReceipt proto.InternalMessageInfo
+
+func (m *ExecutionReceipt) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ExecutionReceipt) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ExecutionReceipt) GetPacketSequence() uint64 {
+	if m != nil {
+		return m.PacketSequence
+	}
+	return 0
+}
+
+func (m *ExecutionReceipt) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ExecutionReceipt) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *ExecutionReceipt) GetFailedStage() ExecutionStage {
+	if m != nil {
+		return m.FailedStage
+	}
+	return EXECUTION_STAGE_UNSPECIFIED
+}
+
+func (m *ExecutionReceipt) GetErrorCode() uint32 {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return 0
+}
+
+func (m *ExecutionReceipt) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *ExecutionReceipt) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *ExecutionReceipt) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *ExecutionReceipt) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *ExecutionReceipt) GetMsgResponses() []*types.Any {
+	if m != nil {
+		return m.MsgResponses
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ExecutionReceipt)(nil), "onion.onion.ExecutionReceipt")
+}
+
+func init() { proto.RegisterFile("onion/onion/receipt.proto", fileDescriptor_805d5652b652698e) }
+
+var fileDescriptor_805d5652b652698e = []byte{
+	// 406 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xc1, 0x6e, 0x13, 0x31,
+	0x10, 0x86, 0x63, 0xb6, 0x4d, 0xb2, 0x4e, 0x5a, 0x90, 0xa9, 0xc0, 0x29, 0x62, 0xb5, 0xe2, 0xc2,
+	0x5e, 0xd8, 0x48, 0xe5, 0xc4, 0x05, 0x09, 0x10, 0x12, 0xbd, 0xba, 0xe2, 0xc2, 0x65, 0xe5, 0xae,
+	0xa7, 0xde, 0x15, 0xa9, 0xbd, 0x78, 0xbc, 0x28, 0x79, 0x0b, 0xde, 0x81, 0x97, 0xe1, 0xd8, 0x23,
+	0x47, 0x94, 0xbc, 0x08, 0x5a, 0x3b, 0xa9, 0x7a, 0xb1, 0xf4, 0xfd, 0xff, 0x78, 0x34, 0x33, 0x3f,
+	0x5d, 0x58, 0xd3, 0x5a, 0xb3, 0x8c, 0xaf, 0x83, 0x1a, 0xda, 0xce, 0x97, 0x9d, 0xb3, 0xde, 0xb2,
+	0x59, 0x10, 0xcb, 0xf0, 0x9e, 0x2f, 0xb4, 0xb5, 0x7a, 0x05, 0xcb, 0x60, 0x5d, 0xf7, 0x37, 0x4b,
+	0x69, 0x36, 0xb1, 0xee, 0x9c, 0x3f, 0x6c, 0x01, 0x3f, 0xc1, 0x78, 0x8c, 0xce, 0xab, 0xdf, 0x09,
+	0x7d, 0xf2, 0x79, 0x0d, 0x75, 0xef, 0x5b, 0x6b, 0x44, 0x6c, 0xce, 0x9e, 0xd3, 0x49, 0x67, 0x9d,
+	0xaf, 0x5a, 0xc5, 0x49, 0x4e, 0x8a, 0x54, 0x8c, 0x07, 0xbc, 0x54, 0xec, 0x25, 0xa5, 0x75, 0x23,
+	0x8d, 0x81, 0xd5, 0xe0, 0x3d, 0x0a, 0x5e, 0xba, 0x57, 0x2e, 0x15, 0x7b, 0x4d, 0x1f, 0x77, 0xb2,
+	0xfe, 0x0e, 0xbe, 0x42, 0xf8, 0xd1, 0x83, 0xa9, 0x81, 0x27, 0x39, 0x29, 0x8e, 0xc4, 0x69, 0x94,
+	0xaf, 0xf6, 0x2a, 0x7b, 0x46, 0xc7, 0x0d, 0xb4, 0xba, 0xf1, 0xfc, 0x28, 0x27, 0x45, 0x22, 0xf6,
+	0xc4, 0x38, 0x9d, 0x60, 0x5f, 0xd7, 0x80, 0xc8, 0x8f, 0x73, 0x52, 0x4c, 0xc5, 0x01, 0xd9, 0x7b,
+	0x3a, 0xbf, 0x91, 0xed, 0x0a, 0x54, 0x85, 0x5e, 0x6a, 0xe0, 0xe3, 0x9c, 0x14, 0xa7, 0x17, 0x2f,
+	0xca, 0x07, 0x07, 0x28, 0xef, 0xf7, 0xb8, 0x1a, 0x4a, 0xc4, 0x2c, 0x7e, 0x08, 0x30, 0x4c, 0x0e,
+	0xce, 0x59, 0x57, 0xd5, 0x56, 0x01, 0x9f, 0xe4, 0xa4, 0x38, 0x11, 0x69, 0x50, 0x3e, 0x59, 0x05,
+	0xec, 0x8c, 0x1e, 0x07, 0xe0, 0xd3, 0xb0, 0x53, 0x84, 0x30, 0x4e, 0xab, 0x0d, 0x38, 0xe4, 0x69,
+	0x9e, 0x14, 0xa9, 0x38, 0xe0, 0x70, 0x21, 0xbf, 0xae, 0x1a, 0x89, 0x0d, 0xa7, 0xf1, 0x42, 0x7e,
+	0xfd, 0x45, 0x62, 0xc3, 0x16, 0x74, 0xaa, 0x25, 0x56, 0x3d, 0x82, 0xe2, 0xb3, 0xb0, 0xfb, 0x44,
+	0x4b, 0xfc, 0x8a, 0xa0, 0xd8, 0x3b, 0x7a, 0x72, 0x8b, 0xba, 0x72, 0x80, 0x9d, 0x35, 0x08, 0xc8,
+	0xe7, 0x79, 0x52, 0xcc, 0x2e, 0xce, 0xca, 0x98, 0x5b, 0x79, 0xc8, 0xad, 0xfc, 0x60, 0x36, 0x62,
+	0x7e, 0x8b, 0x5a, 0x1c, 0x2a, 0x3f, 0xbe, 0xf9, 0xb3, 0xcd, 0xc8, 0xdd, 0x36, 0x23, 0xff, 0xb6,
+	0x19, 0xf9, 0xb5, 0xcb, 0x46, 0x77, 0xbb, 0x6c, 0xf4, 0x77, 0x97, 0x8d, 0xbe, 0x3d, 0x8d, 0x99,
+	0xae, 0xf7, 0xd9, 0xfa, 0x4d, 0x07, 0x78, 0x3d, 0x0e, 0xad, 0xde, 0xfe, 0x1f, 0x00, 0x38, 0x0e,
+	0x24, 0xe2, 0x3a, 0x02, 0x00, 0x00,
+}
+
+func (m *ExecutionReceipt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionReceipt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionReceipt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgResponses) > 0 {
+		for iNdEx := len(m.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReceipt(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintReceipt(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintReceipt(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintReceipt(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintReceipt(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ErrorCode != 0 {
+		i = encodeVarintReceipt(dAtA, i, uint64(m.ErrorCode))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.FailedStage != 0 {
+		i = encodeVarintReceipt(dAtA, i, uint64(m.FailedStage))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Height != 0 {
+		i = encodeVarintReceipt(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PacketSequence != 0 {
+		i = encodeVarintReceipt(dAtA, i, uint64(m.PacketSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintReceipt(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintReceipt(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReceipt(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReceipt(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ExecutionReceipt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovReceipt(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovReceipt(uint64(l))
+	}
+	if m.PacketSequence != 0 {
+		n += 1 + sovReceipt(uint64(m.PacketSequence))
+	}
+	if m.Height != 0 {
+		n += 1 + sovReceipt(uint64(m.Height))
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.FailedStage != 0 {
+		n += 1 + sovReceipt(uint64(m.FailedStage))
+	}
+	if m.ErrorCode != 0 {
+		n += 1 + sovReceipt(uint64(m.ErrorCode))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovReceipt(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovReceipt(uint64(l))
+		}
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovReceipt(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovReceipt(uint64(m.GasUsed))
+	}
+	if len(m.MsgResponses) > 0 {
+		for _, e := range m.MsgResponses {
+			l = e.Size()
+			n += 1 + l + sovReceipt(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovReceipt(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReceipt(x uint64) (n int) {
+	return sovReceipt(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ExecutionReceipt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReceipt
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionReceipt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionReceipt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSequence", wireType)
+			}
+			m.PacketSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedStage", wireType)
+			}
+			m.FailedStage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedStage |= ExecutionStage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
+			}
+			m.ErrorCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrorCode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResponses = append(m.MsgResponses, &types.Any{})
+			if err := m.MsgResponses[len(m.MsgResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReceipt(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReceipt
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReceipt(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReceipt
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReceipt
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReceipt
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReceipt
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReceipt
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReceipt        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReceipt          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReceipt = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate checks that exactly one recipient is set.
+func (o ExtensionOptionRecovery) Validate() error {
+	switch {
+	case o.Address != "" && o.ReturnReceiver != "":
+		return errors.New("recovery address and return receiver are mutually exclusive")
+	case o.Address != "":
+		if _, err := sdk.AccAddressFromBech32(o.Address); err != nil {
+			return fmt.Errorf("invalid recovery address: %w", err)
+		}
+	case strings.TrimSpace(o.ReturnReceiver) == "":
+		return errors.New("recovery address or return receiver is required")
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"onion/x/onion/types"
+)
+
+func TestRecoveryValidate(t *testing.T) {
+	specs := map[string]struct {
+		recovery types.ExtensionOptionRecovery
+		expErr   bool
+	}{
+		"address": {
+			recovery: types.ExtensionOptionRecovery{Address: sdk.AccAddress("addr1").String()},
+		},
+		"return receiver": {
+			recovery: types.ExtensionOptionRecovery{ReturnReceiver: "osmo1receiver", ReturnTimeout: 600},
+		},
+		"both": {
+			recovery: types.ExtensionOptionRecovery{Address: sdk.AccAddress("addr1").String(), ReturnReceiver: "osmo1receiver"},
+			expErr:   true,
+		},
+		"none": {
+			expErr: true,
+		},
+		"invalid address": {
+			recovery: types.ExtensionOptionRecovery{Address: "invalid"},
+			expErr:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			err := spec.recovery.Validate()
+			if spec.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
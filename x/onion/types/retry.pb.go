@@ -0,0 +1,908 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: onion/onion/retry.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueuedOnionTx is an onion tx that passed the ante checks but failed during
+// execution. It is kept in the retry queue until expiry_height, anyone can
+// retry it with MsgRetryOnion in the meantime. In atomic mode the failed
+// execution reverts the transfer and nothing is queued.
+type QueuedOnionTx struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// tx_bytes is the encoded onion tx.
+	TxBytes []byte `protobuf:"bytes,2,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// deadline is the deadline of the memo that carried the tx, if any.
+	Deadline *time.Time `protobuf:"bytes,3,opt,name=deadline,proto3,stdtime" json:"deadline,omitempty"`
+	// signers of the onion tx.
+	Signers []string `protobuf:"bytes,4,rep,name=signers,proto3" json:"signers,omitempty"`
+	// tx_hash is the hex encoded hash of the onion tx bytes.
+	TxHash string `protobuf:"bytes,5,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// port_id, channel_id and packet_sequence identify the packet that carried
+	// the tx on this chain's end of the channel.
+	PortId         string `protobuf:"bytes,6,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId      string `protobuf:"bytes,7,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	PacketSequence uint64 `protobuf:"varint,8,opt,name=packet_sequence,json=packetSequence,proto3" json:"packet_sequence,omitempty"`
+	// height is the block height the tx was queued at.
+	Height int64 `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+	// expiry_height is the last height the tx can be retried at. It is the
+	// smaller of the timeout height of the tx and
+	// Params.retry_queue_blocks after height.
+	ExpiryHeight int64 `protobuf:"varint,10,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// retries counts the failed retries so far.
+	Retries uint32 `protobuf:"varint,11,opt,name=retries,proto3" json:"retries,omitempty"`
+	// error is the error of the last failed execution.
+	Error string `protobuf:"bytes,12,opt,name=error,proto3" json:"error,omitempty"`
+	// received are the funds the packet delivered, retries are bound to them
+	// like the first attempt, see ExtensionOptionPacketBinding.
+	Received *ReceivedFunds `protobuf:"bytes,13,opt,name=received,proto3" json:"received,omitempty"`
+}
+
+func (m *QueuedOnionTx) Reset()         { *m = QueuedOnionTx{} }
+func (m *QueuedOnionTx) String() string { return proto.CompactTextString(m) }
+func (*QueuedOnionTx) ProtoMessage()    {}
+func (*QueuedOnionTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_275f2e1739517a49, []int{0}
+}
+func (m *QueuedOnionTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedOnionTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedOnionTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedOnionTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedOnionTx.Merge(m, src)
+}
+func (m *QueuedOnionTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedOnionTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedOnionTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedOnionTx proto.InternalMessageInfo
+
+func (m *QueuedOnionTx) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueuedOnionTx) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+func (m *QueuedOnionTx) GetDeadline() *time.Time {
+	if m != nil {
+		return m.Deadline
+	}
+	return nil
+}
+
+func (m *QueuedOnionTx) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *QueuedOnionTx) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *QueuedOnionTx) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueuedOnionTx) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueuedOnionTx) GetPacketSequence() uint64 {
+	if m != nil {
+		return m.PacketSequence
+	}
+	return 0
+}
+
+func (m *QueuedOnionTx) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueuedOnionTx) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *QueuedOnionTx) GetRetries() uint32 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+func (m *QueuedOnionTx) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *QueuedOnionTx) GetReceived() *ReceivedFunds {
+	if m != nil {
+		return m.Received
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueuedOnionTx)(nil), "onion.onion.QueuedOnionTx")
+}
+
+func init() { proto.RegisterFile("onion/onion/retry.proto", fileDescriptor_275f2e1739517a49) }
+
+var fileDescriptor_275f2e1739517a49 = []byte{
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0x31, 0x8f, 0xd3, 0x30,
+	0x1c, 0xc5, 0xeb, 0xb6, 0xd7, 0xb4, 0xff, 0xb6, 0x87, 0x64, 0x4e, 0x9c, 0xa9, 0x44, 0x2e, 0x82,
+	0x81, 0x2c, 0xa4, 0x12, 0x48, 0x4c, 0x4c, 0x1d, 0xd0, 0xdd, 0x84, 0x30, 0x37, 0xb1, 0x44, 0x69,
+	0xfd, 0x27, 0xb1, 0xe8, 0xc5, 0xc1, 0x76, 0x50, 0xfa, 0x2d, 0xee, 0xab, 0xf0, 0x2d, 0x18, 0x6f,
+	0x64, 0x03, 0xb5, 0x5f, 0x04, 0xc5, 0x4e, 0x4f, 0x5d, 0xac, 0xbc, 0xf7, 0x7b, 0x89, 0x9e, 0xf3,
+	0xe0, 0x52, 0x95, 0x52, 0x95, 0x4b, 0x7f, 0x6a, 0xb4, 0x7a, 0x97, 0x54, 0x5a, 0x59, 0x45, 0xa7,
+	0xce, 0x4a, 0xdc, 0xb9, 0xb8, 0xc8, 0x55, 0xae, 0x9c, 0xbf, 0x6c, 0x9f, 0x7c, 0x64, 0x71, 0x95,
+	0x2b, 0x95, 0x6f, 0x71, 0xe9, 0xd4, 0xba, 0xfe, 0xb6, 0xb4, 0xf2, 0x0e, 0x8d, 0xcd, 0xee, 0xaa,
+	0x2e, 0xc0, 0x4e, 0x3f, 0x5e, 0x65, 0x9b, 0xef, 0x68, 0x3d, 0x79, 0xf9, 0x6b, 0x00, 0xf3, 0xcf,
+	0x35, 0xd6, 0x28, 0x3e, 0xb5, 0xf0, 0xb6, 0xa1, 0xe7, 0xd0, 0x97, 0x82, 0x91, 0x88, 0xc4, 0x43,
+	0xde, 0x97, 0x82, 0x3e, 0x87, 0xb1, 0x6d, 0xd2, 0xf5, 0xce, 0xa2, 0x61, 0xfd, 0x88, 0xc4, 0x33,
+	0x1e, 0xd8, 0x66, 0xd5, 0x4a, 0xfa, 0x01, 0xc6, 0x02, 0x33, 0xb1, 0x95, 0x25, 0xb2, 0x41, 0x44,
+	0xe2, 0xe9, 0xdb, 0x45, 0xe2, 0xab, 0x24, 0xc7, 0x2a, 0xc9, 0xed, 0xb1, 0xca, 0x6a, 0x78, 0xff,
+	0xf7, 0x8a, 0xf0, 0xc7, 0x37, 0x28, 0x83, 0xc0, 0xc8, 0xbc, 0x44, 0x6d, 0xd8, 0x30, 0x1a, 0xc4,
+	0x13, 0x7e, 0x94, 0xf4, 0x12, 0x02, 0xdb, 0xa4, 0x45, 0x66, 0x0a, 0x76, 0x16, 0x91, 0x78, 0xc2,
+	0x47, 0xb6, 0xb9, 0xce, 0x4c, 0xd1, 0x82, 0x4a, 0x69, 0x9b, 0x4a, 0xc1, 0x46, 0x1e, 0xb4, 0xf2,
+	0x46, 0xd0, 0x17, 0x00, 0x9b, 0x22, 0x2b, 0x4b, 0xdc, 0xb6, 0x2c, 0x70, 0x6c, 0xd2, 0x39, 0x37,
+	0x82, 0xbe, 0x86, 0x27, 0xfe, 0xd6, 0xa9, 0xc1, 0x1f, 0x35, 0x96, 0x1b, 0x64, 0x63, 0x77, 0xc1,
+	0x73, 0x6f, 0x7f, 0xe9, 0x5c, 0xfa, 0x0c, 0x46, 0x05, 0xca, 0xbc, 0xb0, 0x6c, 0x12, 0x91, 0x78,
+	0xc0, 0x3b, 0x45, 0x5f, 0xc1, 0x1c, 0x9b, 0x4a, 0xea, 0x5d, 0xda, 0x61, 0x70, 0x78, 0xe6, 0xcd,
+	0x6b, 0x1f, 0x62, 0x10, 0xb4, 0xc3, 0x49, 0x34, 0x6c, 0x1a, 0x91, 0x78, 0xce, 0x8f, 0x92, 0x5e,
+	0xc0, 0x19, 0x6a, 0xad, 0x34, 0x9b, 0xb9, 0x66, 0x5e, 0xd0, 0xf7, 0x30, 0xd6, 0xb8, 0x41, 0xf9,
+	0x13, 0x05, 0x9b, 0x77, 0xbf, 0xef, 0x64, 0xec, 0x84, 0x77, 0xf0, 0x63, 0x5d, 0x0a, 0xc3, 0x1f,
+	0xb3, 0xab, 0x37, 0xbf, 0xf7, 0x21, 0x79, 0xd8, 0x87, 0xe4, 0xdf, 0x3e, 0x24, 0xf7, 0x87, 0xb0,
+	0xf7, 0x70, 0x08, 0x7b, 0x7f, 0x0e, 0x61, 0xef, 0xeb, 0x53, 0xbf, 0x70, 0xd3, 0x2d, 0x6d, 0x77,
+	0x15, 0x9a, 0xf5, 0xc8, 0x6d, 0xf1, 0xee, 0xff, 0x00, 0x2e, 0x75, 0xd7, 0x3c, 0x62, 0x02, 0x00,
+	0x00,
+}
+
+func (m *QueuedOnionTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedOnionTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedOnionTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Received != nil {
+		{
+			size, err := m.Received.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRetry(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintRetry(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.Retries != 0 {
+		i = encodeVarintRetry(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintRetry(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Height != 0 {
+		i = encodeVarintRetry(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PacketSequence != 0 {
+		i = encodeVarintRetry(dAtA, i, uint64(m.PacketSequence))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintRetry(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintRetry(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintRetry(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintRetry(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Deadline != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintRetry(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintRetry(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRetry(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRetry(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRetry(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueuedOnionTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRetry(uint64(m.Id))
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovRetry(uint64(l))
+	}
+	if m.Deadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Deadline)
+		n += 1 + l + sovRetry(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovRetry(uint64(l))
+		}
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovRetry(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovRetry(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovRetry(uint64(l))
+	}
+	if m.PacketSequence != 0 {
+		n += 1 + sovRetry(uint64(m.PacketSequence))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRetry(uint64(m.Height))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovRetry(uint64(m.ExpiryHeight))
+	}
+	if m.Retries != 0 {
+		n += 1 + sovRetry(uint64(m.Retries))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovRetry(uint64(l))
+	}
+	if m.Received != nil {
+		l = m.Received.Size()
+		n += 1 + l + sovRetry(uint64(l))
+	}
+	return n
+}
+
+func sovRetry(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRetry(x uint64) (n int) {
+	return sovRetry(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueuedOnionTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRetry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedOnionTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedOnionTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRetry
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRetry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSequence", wireType)
+			}
+			m.PacketSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRetry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRetry
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRetry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Received == nil {
+				m.Received = &ReceivedFunds{}
+			}
+			if err := m.Received.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRetry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRetry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRetry(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRetry
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRetry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRetry
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRetry
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRetry
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRetry        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRetry          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRetry = fmt.Errorf("proto: unexpected end of group")
+)
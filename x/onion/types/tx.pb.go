@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -126,35 +127,329 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgExecuteOnion is the Msg/ExecuteOnion request type. Any account can
+// submit the onion tx of another signer, the submitter pays the gas of the
+// tx and receives its fee in place of a relayer.
+type MsgExecuteOnion struct {
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// tx_bytes is the encoded onion tx, as carried base64 encoded in a memo.
+	TxBytes []byte `protobuf:"bytes,2,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+}
+
+func (m *MsgExecuteOnion) Reset()         { *m = MsgExecuteOnion{} }
+func (m *MsgExecuteOnion) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteOnion) ProtoMessage()    {}
+func (*MsgExecuteOnion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0fec1d1516e83ae, []int{2}
+}
+func (m *MsgExecuteOnion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteOnion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteOnion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecuteOnion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteOnion.Merge(m, src)
+}
+func (m *MsgExecuteOnion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteOnion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteOnion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecuteOnion proto.InternalMessageInfo
+
+func (m *MsgExecuteOnion) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *MsgExecuteOnion) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
+	}
+	return nil
+}
+
+// MsgExecuteOnionResponse defines the response structure for executing a
+// MsgExecuteOnion message. An onion tx that fails to decode or in the ante
+// checks fails the msg. One whose messages fail does not, its fee is paid and
+// its onion sequences are used like for a regular tx.
+type MsgExecuteOnionResponse struct {
+	// tx_hash is the hex encoded hash of the onion tx bytes.
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// msg_responses holds the responses of the onion tx messages when the
+	// execution succeeded.
+	MsgResponses []*types.Any `protobuf:"bytes,2,rep,name=msg_responses,json=msgResponses,proto3" json:"msg_responses,omitempty"`
+	Success      bool         `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// failed_stage is unspecified when the execution succeeded.
+	FailedStage ExecutionStage `protobuf:"varint,4,opt,name=failed_stage,json=failedStage,proto3,enum=onion.onion.ExecutionStage" json:"failed_stage,omitempty"`
+	Error       string         `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *MsgExecuteOnionResponse) Reset()         { *m = MsgExecuteOnionResponse{} }
+func (m *MsgExecuteOnionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteOnionResponse) ProtoMessage()    {}
+func (*MsgExecuteOnionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0fec1d1516e83ae, []int{3}
+}
+func (m *MsgExecuteOnionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteOnionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteOnionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecuteOnionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteOnionResponse.Merge(m, src)
+}
+func (m *MsgExecuteOnionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteOnionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteOnionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecuteOnionResponse proto.InternalMessageInfo
+
+func (m *MsgExecuteOnionResponse) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *MsgExecuteOnionResponse) GetMsgResponses() []*types.Any {
+	if m != nil {
+		return m.MsgResponses
+	}
+	return nil
+}
+
+func (m *MsgExecuteOnionResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *MsgExecuteOnionResponse) GetFailedStage() ExecutionStage {
+	if m != nil {
+		return m.FailedStage
+	}
+	return EXECUTION_STAGE_UNSPECIFIED
+}
+
+func (m *MsgExecuteOnionResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// MsgRetryOnion is the Msg/RetryOnion request type. Any account can retry a
+// queued onion tx, the sender pays the gas of the tx. The fee was paid to the
+// relayer of the first attempt.
+type MsgRetryOnion struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// id of the queued onion tx.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgRetryOnion) Reset()         { *m = MsgRetryOnion{} }
+func (m *MsgRetryOnion) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryOnion) ProtoMessage()    {}
+func (*MsgRetryOnion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0fec1d1516e83ae, []int{4}
+}
+func (m *MsgRetryOnion) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryOnion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryOnion.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryOnion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryOnion.Merge(m, src)
+}
+func (m *MsgRetryOnion) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryOnion) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryOnion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryOnion proto.InternalMessageInfo
+
+func (m *MsgRetryOnion) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRetryOnion) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgRetryOnionResponse defines the response structure for executing a
+// MsgRetryOnion message. A failed retry does not fail the msg, the tx stays
+// queued when it failed during execution again and is dropped otherwise.
+type MsgRetryOnionResponse struct {
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// failed_stage is unspecified when the execution succeeded.
+	FailedStage ExecutionStage `protobuf:"varint,2,opt,name=failed_stage,json=failedStage,proto3,enum=onion.onion.ExecutionStage" json:"failed_stage,omitempty"`
+	Error       string         `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// msg_responses holds the responses of the onion tx messages when the
+	// execution succeeded.
+	MsgResponses []*types.Any `protobuf:"bytes,4,rep,name=msg_responses,json=msgResponses,proto3" json:"msg_responses,omitempty"`
+}
+
+func (m *MsgRetryOnionResponse) Reset()         { *m = MsgRetryOnionResponse{} }
+func (m *MsgRetryOnionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetryOnionResponse) ProtoMessage()    {}
+func (*MsgRetryOnionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a0fec1d1516e83ae, []int{5}
+}
+func (m *MsgRetryOnionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetryOnionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetryOnionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetryOnionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetryOnionResponse.Merge(m, src)
+}
+func (m *MsgRetryOnionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetryOnionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetryOnionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRetryOnionResponse proto.InternalMessageInfo
+
+func (m *MsgRetryOnionResponse) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *MsgRetryOnionResponse) GetFailedStage() ExecutionStage {
+	if m != nil {
+		return m.FailedStage
+	}
+	return EXECUTION_STAGE_UNSPECIFIED
+}
+
+func (m *MsgRetryOnionResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *MsgRetryOnionResponse) GetMsgResponses() []*types.Any {
+	if m != nil {
+		return m.MsgResponses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "onion.onion.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "onion.onion.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgExecuteOnion)(nil), "onion.onion.MsgExecuteOnion")
+	proto.RegisterType((*MsgExecuteOnionResponse)(nil), "onion.onion.MsgExecuteOnionResponse")
+	proto.RegisterType((*MsgRetryOnion)(nil), "onion.onion.MsgRetryOnion")
+	proto.RegisterType((*MsgRetryOnionResponse)(nil), "onion.onion.MsgRetryOnionResponse")
 }
 
 func init() { proto.RegisterFile("onion/onion/tx.proto", fileDescriptor_a0fec1d1516e83ae) }
 
 var fileDescriptor_a0fec1d1516e83ae = []byte{
-	// 313 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xc9, 0xcf, 0xcb, 0xcc,
-	0xcf, 0xd3, 0x87, 0x90, 0x25, 0x15, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0xdc, 0x60, 0xbe,
-	0x1e, 0x98, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0x79, 0x29, 0xf1,
-	0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0x62, 0xfd, 0xdc, 0xe2, 0x74, 0xfd, 0x32, 0x43, 0x10, 0x05, 0x95,
-	0x90, 0x84, 0x48, 0xc4, 0x83, 0x79, 0xfa, 0x10, 0x0e, 0x54, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f,
-	0x22, 0x0e, 0x62, 0x41, 0x45, 0x25, 0x90, 0xed, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x85, 0xaa, 0x57,
-	0xda, 0xcc, 0xc8, 0xc5, 0xef, 0x5b, 0x9c, 0x1e, 0x5a, 0x90, 0x92, 0x58, 0x92, 0x1a, 0x00, 0x96,
-	0x11, 0x32, 0xe3, 0xe2, 0x4c, 0x2c, 0x2d, 0xc9, 0xc8, 0x2f, 0xca, 0x2c, 0xa9, 0x94, 0x60, 0x54,
-	0x60, 0xd4, 0xe0, 0x74, 0x92, 0xb8, 0xb4, 0x45, 0x57, 0x04, 0x6a, 0x91, 0x63, 0x4a, 0x4a, 0x51,
-	0x6a, 0x71, 0x71, 0x70, 0x49, 0x51, 0x66, 0x5e, 0x7a, 0x10, 0x42, 0xa9, 0x90, 0x19, 0x17, 0x1b,
-	0xc4, 0x6c, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x61, 0x3d, 0x24, 0x0f, 0xea, 0x41, 0x0c,
-	0x77, 0xe2, 0x3c, 0x71, 0x4f, 0x9e, 0x61, 0xc5, 0xf3, 0x0d, 0x5a, 0x8c, 0x41, 0x50, 0xd5, 0x56,
-	0x06, 0x4d, 0xcf, 0x37, 0x68, 0x21, 0xcc, 0xe9, 0x7a, 0xbe, 0x41, 0x4b, 0x16, 0xe2, 0xd4, 0x0a,
-	0xa8, 0x93, 0xd1, 0x5c, 0xa8, 0x24, 0xc9, 0x25, 0x8e, 0x26, 0x14, 0x94, 0x5a, 0x5c, 0x90, 0x9f,
-	0x57, 0x9c, 0x6a, 0x94, 0xc0, 0xc5, 0xec, 0x5b, 0x9c, 0x2e, 0x14, 0xc4, 0xc5, 0x83, 0xe2, 0x27,
-	0x19, 0x14, 0xb7, 0xa0, 0x69, 0x96, 0x52, 0xc1, 0x27, 0x0b, 0x33, 0x5a, 0x8a, 0xb5, 0x01, 0xe4,
-	0x6c, 0x27, 0xdd, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71,
-	0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x12, 0x46, 0x75,
-	0x75, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0xa0, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x2d, 0x4a, 0x1e, 0x16, 0x04, 0x02, 0x00, 0x00,
+	// 630 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xce, 0x25, 0x6d, 0xd2, 0x5c, 0xd2, 0x22, 0xdc, 0xa0, 0x38, 0x2e, 0x98, 0xc8, 0xea, 0x10,
+	0x05, 0xd5, 0x2e, 0x41, 0xaa, 0x44, 0x07, 0xa4, 0x46, 0x42, 0x62, 0x20, 0x02, 0xb9, 0x62, 0x61,
+	0x89, 0x9c, 0xf8, 0xea, 0x58, 0xaa, 0x7d, 0x91, 0xef, 0x52, 0xd9, 0x0b, 0x42, 0x1d, 0x99, 0x98,
+	0xf8, 0x0d, 0x8c, 0x91, 0xe0, 0x17, 0x30, 0x75, 0xac, 0x98, 0x58, 0x40, 0x28, 0x19, 0xf2, 0x37,
+	0x90, 0x7d, 0xe7, 0xc4, 0x4e, 0x4a, 0xa8, 0x58, 0x2e, 0xf9, 0xee, 0x7b, 0xef, 0xf9, 0x7b, 0xdf,
+	0x7b, 0x36, 0xac, 0x60, 0xd7, 0xc6, 0xae, 0xc6, 0x4e, 0xea, 0xab, 0x43, 0x0f, 0x53, 0x2c, 0x94,
+	0x22, 0xac, 0x46, 0xa7, 0x74, 0xd7, 0x70, 0x6c, 0x17, 0x6b, 0xd1, 0xc9, 0x78, 0xa9, 0xda, 0xc7,
+	0xc4, 0xc1, 0x44, 0x73, 0x88, 0xa5, 0x5d, 0x3c, 0x0e, 0x7f, 0x38, 0x51, 0x63, 0x44, 0x37, 0x42,
+	0x1a, 0x03, 0x9c, 0xaa, 0x58, 0xd8, 0xc2, 0xec, 0x3e, 0xfc, 0x17, 0x27, 0x58, 0x18, 0x5b, 0xe7,
+	0x48, 0x8b, 0x50, 0x6f, 0x74, 0xa6, 0x19, 0x6e, 0xc0, 0x29, 0x31, 0x29, 0x0d, 0x5d, 0x20, 0x97,
+	0x92, 0x9b, 0x98, 0xa1, 0xe1, 0x19, 0x0e, 0x67, 0x94, 0x2f, 0x00, 0xde, 0xe9, 0x10, 0xeb, 0xcd,
+	0xd0, 0x34, 0x28, 0x7a, 0x1d, 0x31, 0xc2, 0x11, 0x2c, 0x1a, 0x23, 0x3a, 0xc0, 0x9e, 0x4d, 0x03,
+	0x11, 0xd4, 0x41, 0xa3, 0xd8, 0x16, 0xbf, 0x7f, 0x3d, 0xa8, 0x70, 0x75, 0x27, 0xa6, 0xe9, 0x21,
+	0x42, 0x4e, 0xa9, 0x67, 0xbb, 0x96, 0xbe, 0x08, 0x15, 0x8e, 0x60, 0x9e, 0xd5, 0x16, 0xb3, 0x75,
+	0xd0, 0x28, 0xb5, 0x76, 0xd5, 0x84, 0x2b, 0x2a, 0x2b, 0xde, 0x2e, 0x5e, 0xfd, 0x7a, 0x98, 0xf9,
+	0x3c, 0x1b, 0x37, 0x81, 0xce, 0xa3, 0x8f, 0x0f, 0x2f, 0x67, 0xe3, 0xe6, 0xa2, 0xce, 0x87, 0xd9,
+	0xb8, 0xf9, 0x80, 0x49, 0xf5, 0xb9, 0xe4, 0x25, 0x85, 0x4a, 0x0d, 0x56, 0x97, 0xae, 0x74, 0x44,
+	0x86, 0xd8, 0x25, 0x48, 0xf9, 0xc4, 0x1a, 0x7a, 0xee, 0xa3, 0xfe, 0x88, 0xa2, 0x57, 0x61, 0x7a,
+	0xd8, 0x10, 0x19, 0xf5, 0x1c, 0x9b, 0x52, 0xe4, 0xfd, 0xbb, 0xa1, 0x79, 0xa8, 0x50, 0x83, 0x5b,
+	0xd4, 0xef, 0xf6, 0x02, 0x8a, 0x58, 0x4b, 0x65, 0xbd, 0x40, 0xfd, 0x76, 0x08, 0xb9, 0xe6, 0x79,
+	0xe8, 0xcd, 0x9a, 0x93, 0x22, 0x94, 0x9f, 0x00, 0x56, 0x97, 0xee, 0x62, 0xd1, 0x42, 0x15, 0x16,
+	0xa8, 0xdf, 0x1d, 0x18, 0x64, 0xc0, 0xe4, 0xe9, 0x79, 0xea, 0xbf, 0x30, 0xc8, 0x40, 0x78, 0x0a,
+	0xb7, 0x1d, 0x62, 0x75, 0x3d, 0x1e, 0x18, 0xca, 0xc8, 0x35, 0x4a, 0xad, 0x8a, 0xca, 0xb6, 0x40,
+	0x8d, 0xb7, 0x40, 0x3d, 0x71, 0x03, 0xbd, 0xec, 0x10, 0x2b, 0x2e, 0x49, 0x04, 0x11, 0x16, 0xc8,
+	0xa8, 0xdf, 0x47, 0x84, 0x88, 0xb9, 0x3a, 0x68, 0x6c, 0xe9, 0x31, 0x14, 0x9e, 0xc1, 0xf2, 0x99,
+	0x61, 0x9f, 0x23, 0xb3, 0x4b, 0xa8, 0x61, 0x21, 0x71, 0xa3, 0x0e, 0x1a, 0x3b, 0xad, 0xbd, 0xd4,
+	0xb4, 0x98, 0x4c, 0x1b, 0xbb, 0xa7, 0x61, 0x88, 0x5e, 0x62, 0x09, 0x11, 0x10, 0x2a, 0x70, 0x13,
+	0x79, 0x1e, 0xf6, 0xc4, 0xcd, 0x48, 0x2b, 0x03, 0xca, 0x3b, 0xb8, 0xdd, 0x09, 0x9f, 0x4f, 0xbd,
+	0x80, 0xb9, 0x7e, 0x08, 0xf3, 0x04, 0xb9, 0xe6, 0x2d, 0x2c, 0xe7, 0x71, 0xc2, 0x0e, 0xcc, 0xda,
+	0x66, 0xe4, 0xf4, 0x86, 0x9e, 0xb5, 0xcd, 0xe3, 0x47, 0xa1, 0xc9, 0x9c, 0x0c, 0x1d, 0xde, 0x5b,
+	0x71, 0x78, 0xf1, 0x38, 0xe5, 0x1b, 0x80, 0xf7, 0x52, 0x37, 0x73, 0x77, 0x13, 0x4e, 0x80, 0xf5,
+	0x4e, 0x64, 0xff, 0xd7, 0x89, 0x5c, 0xc2, 0x89, 0xd5, 0xa1, 0x6d, 0xdc, 0x76, 0x68, 0xad, 0xcb,
+	0x2c, 0xcc, 0x75, 0x88, 0x25, 0xe8, 0xb0, 0x9c, 0x7a, 0x25, 0xef, 0xa7, 0x24, 0x2d, 0xed, 0xbe,
+	0xb4, 0xbf, 0x8e, 0x9d, 0xdb, 0xa0, 0xc3, 0x72, 0xea, 0xad, 0x58, 0xa9, 0x99, 0x64, 0xa5, 0xfd,
+	0x75, 0xec, 0xbc, 0xe6, 0x4b, 0x08, 0x13, 0x13, 0x97, 0x96, 0x73, 0x16, 0x9c, 0xa4, 0xfc, 0x9d,
+	0x8b, 0xab, 0x49, 0x9b, 0xef, 0xc3, 0xef, 0x42, 0xfb, 0xe0, 0x6a, 0x22, 0x83, 0xeb, 0x89, 0x0c,
+	0x7e, 0x4f, 0x64, 0xf0, 0x71, 0x2a, 0x67, 0xae, 0xa7, 0x72, 0xe6, 0xc7, 0x54, 0xce, 0xbc, 0xdd,
+	0x4d, 0x2f, 0x00, 0x0d, 0x86, 0x88, 0xf4, 0xf2, 0x91, 0x9f, 0x4f, 0xfe, 0x0c, 0x00, 0x10, 0xa1,
+	0x5d, 0x17, 0x9a, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -172,6 +467,11 @@ type MsgClient interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// ExecuteOnion executes a signed onion tx without an ICS-20 packet, e.g.
+	// when the transfer carrying it was never sent or timed out.
+	ExecuteOnion(ctx context.Context, in *MsgExecuteOnion, opts ...grpc.CallOption) (*MsgExecuteOnionResponse, error)
+	// RetryOnion executes an onion tx of the retry queue again.
+	RetryOnion(ctx context.Context, in *MsgRetryOnion, opts ...grpc.CallOption) (*MsgRetryOnionResponse, error)
 }
 
 type msgClient struct {
@@ -191,11 +491,34 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) ExecuteOnion(ctx context.Context, in *MsgExecuteOnion, opts ...grpc.CallOption) (*MsgExecuteOnionResponse, error) {
+	out := new(MsgExecuteOnionResponse)
+	err := c.cc.Invoke(ctx, "/onion.onion.Msg/ExecuteOnion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RetryOnion(ctx context.Context, in *MsgRetryOnion, opts ...grpc.CallOption) (*MsgRetryOnionResponse, error) {
+	out := new(MsgRetryOnionResponse)
+	err := c.cc.Invoke(ctx, "/onion.onion.Msg/RetryOnion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams defines a (governance) operation for updating the module
 	// parameters. The authority defaults to the x/gov module account.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// ExecuteOnion executes a signed onion tx without an ICS-20 packet, e.g.
+	// when the transfer carrying it was never sent or timed out.
+	ExecuteOnion(context.Context, *MsgExecuteOnion) (*MsgExecuteOnionResponse, error)
+	// RetryOnion executes an onion tx of the retry queue again.
+	RetryOnion(context.Context, *MsgRetryOnion) (*MsgRetryOnionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -205,6 +528,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) ExecuteOnion(ctx context.Context, req *MsgExecuteOnion) (*MsgExecuteOnionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteOnion not implemented")
+}
+func (*UnimplementedMsgServer) RetryOnion(ctx context.Context, req *MsgRetryOnion) (*MsgRetryOnionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryOnion not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -228,6 +557,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ExecuteOnion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteOnion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteOnion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onion.onion.Msg/ExecuteOnion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteOnion(ctx, req.(*MsgExecuteOnion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetryOnion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetryOnion)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetryOnion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/onion.onion.Msg/RetryOnion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetryOnion(ctx, req.(*MsgRetryOnion))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "onion.onion.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -236,6 +601,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "ExecuteOnion",
+			Handler:    _Msg_ExecuteOnion_Handler,
+		},
+		{
+			MethodName: "RetryOnion",
+			Handler:    _Msg_RetryOnion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "onion/onion/tx.proto",
@@ -304,59 +677,343 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgExecuteOnion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgExecuteOnion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteOnion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgExecuteOnionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgExecuteOnionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+
+func (m *MsgExecuteOnionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.FailedStage != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FailedStage))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MsgResponses) > 0 {
+		for iNdEx := len(m.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryOnion) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryOnion) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryOnion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetryOnionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetryOnionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetryOnionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgResponses) > 0 {
+		for iNdEx := len(m.MsgResponses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgResponses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FailedStage != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FailedStage))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExecuteOnion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgExecuteOnionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MsgResponses) > 0 {
+		for _, e := range m.MsgResponses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.FailedStage != 0 {
+		n += 1 + sovTx(uint64(m.FailedStage))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRetryOnion) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgRetryOnionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Success {
+		n += 2
+	}
+	if m.FailedStage != 0 {
+		n += 1 + sovTx(uint64(m.FailedStage))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MsgResponses) > 0 {
+		for _, e := range m.MsgResponses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
@@ -510,6 +1167,565 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgExecuteOnion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteOnion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteOnion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxBytes = append(m.TxBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxBytes == nil {
+				m.TxBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteOnionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteOnionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteOnionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResponses = append(m.MsgResponses, &types.Any{})
+			if err := m.MsgResponses[len(m.MsgResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedStage", wireType)
+			}
+			m.FailedStage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedStage |= ExecutionStage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetryOnion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetryOnion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetryOnion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetryOnionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetryOnionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetryOnionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedStage", wireType)
+			}
+			m.FailedStage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedStage |= ExecutionStage(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgResponses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgResponses = append(m.MsgResponses, &types.Any{})
+			if err := m.MsgResponses[len(m.MsgResponses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0